@@ -3,9 +3,6 @@ package handlers
 import (
 	"errors"
 	"fmt"
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
 	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
@@ -27,9 +24,7 @@ import (
 type AddAdminHandler struct{}
 
 func (h *AddAdminHandler) Matcher() matcher.Matcher {
-	return matcher.NewFuncMatcher(func(customId string) bool {
-		return strings.HasPrefix(customId, "addadmin")
-	})
+	return matcher.NewPatternMatcher("addadmin-{type:int}-{id:u64}")
 }
 
 func (h *AddAdminHandler) Properties() registry.Properties {
@@ -39,8 +34,6 @@ func (h *AddAdminHandler) Properties() registry.Properties {
 	}
 }
 
-var addAdminPattern = regexp.MustCompile(`addadmin-(\d)-(\d+)`)
-
 func (h *AddAdminHandler) Execute(ctx *context.ButtonContext) {
 	// Permission check
 	permLevel, err := ctx.UserPermissionLevel(ctx)
@@ -55,22 +48,8 @@ func (h *AddAdminHandler) Execute(ctx *context.ButtonContext) {
 	}
 
 	// Extract data from custom ID
-	groups := addAdminPattern.FindStringSubmatch(ctx.InteractionData.CustomId)
-	if len(groups) < 3 {
-		return
-	}
-
-	mentionableTypeRaw, err := strconv.Atoi(groups[1])
-	if err != nil {
-		return
-	}
-
-	mentionableType := context.MentionableType(mentionableTypeRaw)
-
-	id, err := strconv.ParseUint(groups[2], 10, 64)
-	if err != nil {
-		return
-	}
+	mentionableType := context.MentionableType(ctx.Params.Int("type"))
+	id := ctx.Params.Uint64("id")
 
 	if mentionableType == context.MentionableTypeUser {
 		// Guild owner doesn't need to be added
@@ -3,9 +3,6 @@ package handlers
 import (
 	"errors"
 	"fmt"
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
 	database "github.com/jadevelopmentgrp/Tickets-Database"
@@ -29,9 +26,7 @@ import (
 type AddSupportHandler struct{}
 
 func (h *AddSupportHandler) Matcher() matcher.Matcher {
-	return matcher.NewFuncMatcher(func(customId string) bool {
-		return strings.HasPrefix(customId, "addsupport")
-	})
+	return matcher.NewPatternMatcher("addsupport-{type:int}-{id:u64}")
 }
 
 func (h *AddSupportHandler) Properties() registry.Properties {
@@ -41,8 +36,6 @@ func (h *AddSupportHandler) Properties() registry.Properties {
 	}
 }
 
-var addSupportPattern = regexp.MustCompile(`addsupport-(\d)-(\d+)`)
-
 func (h *AddSupportHandler) Execute(ctx *context.ButtonContext) {
 	// Permission check
 	permLevel, err := ctx.UserPermissionLevel(ctx)
@@ -57,22 +50,8 @@ func (h *AddSupportHandler) Execute(ctx *context.ButtonContext) {
 	}
 
 	// Extract data from custom ID
-	groups := addSupportPattern.FindStringSubmatch(ctx.InteractionData.CustomId)
-	if len(groups) < 3 {
-		return
-	}
-
-	mentionableTypeRaw, err := strconv.Atoi(groups[1])
-	if err != nil {
-		return
-	}
-
-	mentionableType := context.MentionableType(mentionableTypeRaw)
-
-	id, err := strconv.ParseUint(groups[2], 10, 64)
-	if err != nil {
-		return
-	}
+	mentionableType := context.MentionableType(ctx.Params.Int("type"))
+	id := ctx.Params.Uint64("id")
 
 	if mentionableType == context.MentionableTypeUser {
 		ctx.ReplyRaw(customisation.Red, "Error", "Users in support teams are now deprecated. Please use roles instead.")
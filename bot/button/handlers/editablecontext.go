@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+)
+
+// editableContext is implemented by both button and select menu contexts, for handlers that update the message that
+// the component is attached to
+type editableContext interface {
+	cmdregistry.CommandContext
+	Edit(data command.MessageResponse)
+}
@@ -0,0 +1,422 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/signing"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+// exitSurvey is the survey attached to a ticket's panel
+type exitSurvey struct {
+	Ticket database.Ticket
+	Form   database.Form
+	Inputs []database.FormInput
+	// Options holds the choices for questions answered from a select menu, keyed by form input ID. Other questions
+	// are answered in the modal.
+	Options map[int][]string
+}
+
+func (s exitSurvey) isSelect(input database.FormInput) bool {
+	_, ok := s.Options[input.Id]
+	return ok
+}
+
+func (s exitSurvey) hasSelectQuestions() bool {
+	for _, input := range s.Inputs {
+		if s.isSelect(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s exitSurvey) hasTextQuestions() bool {
+	for _, input := range s.Inputs {
+		if !s.isSelect(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exitSurveyState holds the answers to the select questions while the user moves on to the modal
+type exitSurveyState struct {
+	GuildId  uint64 `json:"guild_id"`
+	TicketId int    `json:"ticket_id"`
+	FormId   int    `json:"form_id"`
+	// Answers is keyed by form input custom ID
+	Answers map[string]string `json:"answers"`
+}
+
+// loadExitSurvey checks that the user may answer the ticket's exit survey, and loads its questions. If the survey
+// cannot be answered, the user is told why and ok is false.
+func loadExitSurvey(ctx context.Context, cmd cmdregistry.CommandContext, guildId uint64, ticketId int) (exitSurvey, bool) {
+	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, guildId)
+	if err != nil {
+		cmd.HandleError(err)
+		return exitSurvey{}, false
+	}
+
+	if ticket.UserId != cmd.UserId() || ticket.GuildId != guildId || ticket.Id != ticketId {
+		return exitSurvey{}, false
+	}
+
+	feedbackEnabled, err := dbclient.Client.FeedbackEnabled.Get(ctx, guildId)
+	if err != nil {
+		cmd.HandleError(err)
+		return exitSurvey{}, false
+	}
+
+	if !feedbackEnabled {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageFeedbackDisabled)
+		return exitSurvey{}, false
+	}
+
+	if ticket.PanelId == nil {
+		cmd.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
+		return exitSurvey{}, false
+	}
+
+	panel, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+	if err != nil {
+		cmd.HandleError(err)
+		return exitSurvey{}, false
+	}
+
+	if panel.GuildId != guildId || panel.PanelId != *ticket.PanelId {
+		cmd.HandleError(fmt.Errorf("panel not found"))
+		return exitSurvey{}, false
+	}
+
+	if panel.ExitSurveyFormId == nil {
+		cmd.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
+		return exitSurvey{}, false
+	}
+
+	form, ok, err := dbclient.Client.Forms.Get(ctx, *panel.ExitSurveyFormId)
+	if err != nil {
+		cmd.HandleError(err)
+		return exitSurvey{}, false
+	}
+
+	if !ok {
+		cmd.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
+		return exitSurvey{}, false
+	}
+
+	inputs, err := dbclient.Client.FormInput.GetInputs(ctx, form.Id)
+	if err != nil {
+		cmd.HandleError(err)
+		return exitSurvey{}, false
+	}
+
+	inputIds := make([]int, len(inputs))
+	for i, input := range inputs {
+		inputIds[i] = input.Id
+	}
+
+	options, err := dbclient.Local.ExitSurveySelectOptions.GetAll(ctx, inputIds)
+	if err != nil {
+		cmd.HandleError(err)
+		return exitSurvey{}, false
+	}
+
+	return exitSurvey{
+		Ticket:  ticket,
+		Form:    form,
+		Inputs:  inputs,
+		Options: options,
+	}, true
+}
+
+// loadExitSurveyState decodes the answers to the select questions, checking that the survey still matches them
+func loadExitSurveyState(ctx context.Context, cmd cmdregistry.CommandContext, s *state.State) (exitSurvey, exitSurveyState, bool) {
+	if s == nil {
+		return exitSurvey{}, exitSurveyState{}, false
+	}
+
+	var progress exitSurveyState
+	if err := s.Decode(&progress); err != nil {
+		cmd.HandleError(err)
+		return exitSurvey{}, exitSurveyState{}, false
+	}
+
+	survey, ok := loadExitSurvey(ctx, cmd, progress.GuildId, progress.TicketId)
+	if !ok {
+		return exitSurvey{}, exitSurveyState{}, false
+	}
+
+	if survey.Form.Id != progress.FormId {
+		cmd.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
+		return exitSurvey{}, exitSurveyState{}, false
+	}
+
+	return survey, progress, true
+}
+
+// buildExitSurveyModal builds a modal for the questions answered in a text box. token is the state token holding the
+// answers to the select questions, and is empty if the survey has none.
+func buildExitSurveyModal(survey exitSurvey, token string) button.ResponseModal {
+	var components []component.Component
+	for _, input := range survey.Inputs {
+		if survey.isSelect(input) {
+			continue
+		}
+
+		var minLength, maxLength *uint32
+		if input.MinLength != nil && *input.MinLength > 0 {
+			minLength = utils.Ptr(uint32(*input.MinLength))
+		}
+
+		if input.MaxLength != nil {
+			maxLength = utils.Ptr(uint32(*input.MaxLength))
+		}
+
+		components = append(components, component.BuildActionRow(component.BuildInputText(component.InputText{
+			Style:       component.TextStyleTypes(input.Style),
+			CustomId:    input.CustomId,
+			Label:       input.Label,
+			Placeholder: input.Placeholder,
+			MinLength:   minLength,
+			MaxLength:   maxLength,
+			Required:    utils.Ptr(input.Required),
+			Value:       nil,
+		})))
+	}
+
+	customId := fmt.Sprintf("exit-survey-%d-%d", survey.Ticket.GuildId, survey.Ticket.Id)
+	if token != "" {
+		customId = state.Attach(customId, token)
+	}
+
+	return button.ResponseModal{
+		Data: interaction.ModalResponseData{
+			CustomId:   signing.Sign(customId),
+			Title:      survey.Form.Title,
+			Components: components,
+		},
+	}
+}
+
+// buildExitSurveySelectMessage asks the select questions, as Discord does not allow select menus in a modal
+func buildExitSurveySelectMessage(cmd cmdregistry.CommandContext, survey exitSurvey, progress exitSurveyState, token string) command.MessageResponse {
+	e := utils.BuildEmbedRaw(cmd.GetColour(customisation.Green), survey.Form.Title, cmd.GetMessage(i18n.MessageExitSurveySelectPrompt), nil)
+
+	var components []component.Component
+	for _, input := range survey.Inputs {
+		options, ok := survey.Options[input.Id]
+		if !ok {
+			continue
+		}
+
+		if len(components) == dbclient.MaxExitSurveySelectQuestions {
+			break
+		}
+
+		menuOptions := make([]component.SelectOption, len(options))
+		for i, option := range options {
+			menuOptions[i] = component.SelectOption{
+				Label:   option,
+				Value:   option,
+				Default: progress.Answers[input.CustomId] == option,
+			}
+		}
+
+		components = append(components, component.BuildActionRow(component.BuildSelectMenu(component.SelectMenu{
+			CustomId:    state.Attach(fmt.Sprintf("exit-survey-select-%d", input.Id), token),
+			Options:     menuOptions,
+			Placeholder: input.Label,
+			MinValues:   utils.Ptr(1),
+			MaxValues:   utils.Ptr(1),
+		})))
+	}
+
+	components = append(components, component.BuildActionRow(component.BuildButton(component.Button{
+		Label:    cmd.GetMessage(i18n.MessageFormContinueButton),
+		CustomId: state.Attach("exit-survey-continue", token),
+		Style:    component.ButtonStylePrimary,
+		Emoji:    utils.BuildEmoji("➡️"),
+	})))
+
+	return command.NewEphemeralEmbedMessageResponseWithComponents(e, components)
+}
+
+// submitExitSurvey stores the answers, keyed by form input custom ID, and sends them to the guild's webhooks
+func submitExitSurvey(ctx context.Context, cmd cmdregistry.CommandContext, survey exitSurvey, answers map[string]string) error {
+	responses := make(map[int]string)
+	var webhookAnswers []webhooks.SurveyAnswer
+	for _, input := range survey.Inputs {
+		answer, ok := answers[input.CustomId]
+		if !ok {
+			continue
+		}
+
+		responses[input.Id] = answer
+		webhookAnswers = append(webhookAnswers, webhooks.SurveyAnswer{
+			Question: input.Label,
+			Answer:   answer,
+		})
+	}
+
+	if err := dbclient.Client.ExitSurveyResponses.AddResponses(ctx, survey.Ticket.GuildId, survey.Ticket.Id, survey.Form.Id, responses); err != nil {
+		return err
+	}
+
+	if err := webhooks.Dispatch(ctx, survey.Ticket.GuildId, survey.Ticket.Id, webhooks.EventSurveyAnswered, cmd.UserId(), webhooks.SurveyData{
+		Answers: webhookAnswers,
+	}); err != nil {
+		fmt.Print(err, cmd.ToErrorContext())
+	}
+
+	return nil
+}
+
+func addViewFeedbackButton(ctx context.Context, cmd cmdregistry.CommandContext, ticket database.Ticket) error {
+	// Get archive message
+	settings, err := dbclient.Client.Settings.Get(ctx, ticket.GuildId)
+	if err != nil {
+		return err
+	}
+
+	closeMetadata, ok, err := dbclient.Client.CloseReason.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	var closedBy uint64
+	var reason *string
+	if ok {
+		reason = closeMetadata.Reason
+
+		if closeMetadata.ClosedBy != nil {
+			closedBy = *closeMetadata.ClosedBy
+		}
+	}
+
+	rating, err := logic.GetFeedbackRating(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	if rating == nil {
+		return fmt.Errorf("exit survey was completed, but no rating was found (%d:%d)", ticket.GuildId, ticket.Id)
+	}
+
+	return logic.EditGuildArchiveMessageIfExists(ctx, cmd.Worker(), ticket, settings, true, closedBy, reason, rating)
+}
+
+// ExitSurveySelectHandler records the answer to a select question
+type ExitSurveySelectHandler struct{}
+
+func (h *ExitSurveySelectHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("exit-survey-select-{input:int}")
+}
+
+func (h *ExitSurveySelectHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.DMsAllowed, registry.CanEdit),
+		Timeout: time.Second * 5,
+	}
+}
+
+func (h *ExitSurveySelectHandler) Execute(ctx *cmdcontext.SelectMenuContext) {
+	if len(ctx.InteractionData.Values) == 0 {
+		return
+	}
+
+	survey, progress, ok := loadExitSurveyState(ctx, ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	inputId := ctx.Params.Int("input")
+	value := ctx.InteractionData.Values[0]
+
+	for _, input := range survey.Inputs {
+		if input.Id != inputId || !utils.Contains(survey.Options[input.Id], value) {
+			continue
+		}
+
+		progress.Answers[input.CustomId] = value
+
+		if err := ctx.State.Update(ctx, progress); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Edit(buildExitSurveySelectMessage(ctx, survey, progress, ctx.State.Token))
+		return
+	}
+}
+
+// ExitSurveyContinueHandler opens the modal for the text questions once the select questions have been answered, or
+// submits the survey if it only has select questions
+type ExitSurveyContinueHandler struct{}
+
+func (h *ExitSurveyContinueHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "exit-survey-continue",
+	}
+}
+
+func (h *ExitSurveyContinueHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.DMsAllowed, registry.CanEdit),
+		Timeout: time.Second * 8,
+	}
+}
+
+func (h *ExitSurveyContinueHandler) Execute(ctx *cmdcontext.ButtonContext) {
+	survey, progress, ok := loadExitSurveyState(ctx, ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	for _, input := range survey.Inputs {
+		if survey.isSelect(input) && input.Required && progress.Answers[input.CustomId] == "" {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageExitSurveySelectRequired, input.Label)
+			return
+		}
+	}
+
+	if survey.hasTextQuestions() {
+		ctx.Modal(buildExitSurveyModal(survey, ctx.State.Token))
+		return
+	}
+
+	if err := submitExitSurvey(ctx, ctx, survey, progress.Answers); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if _, err := ctx.State.Delete(ctx); err != nil {
+		fmt.Print(err, ctx.ToErrorContext())
+	}
+
+	ctx.EditWithRaw(customisation.Green, "Success", "Thank you for your feedback!") // TODO: i18n
+
+	if err := addViewFeedbackButton(ctx, ctx, survey.Ticket); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+}
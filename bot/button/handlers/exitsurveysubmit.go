@@ -3,171 +3,80 @@ package handlers
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
-	"github.com/jadevelopmentgrp/Tickets-Database"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
 	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
-	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
-	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
-	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 )
 
 type ExitSurveySubmitHandler struct{}
 
 func (h *ExitSurveySubmitHandler) Matcher() matcher.Matcher {
-	return matcher.NewFuncMatcher(func(customId string) bool {
-		return strings.HasPrefix(customId, "exit-survey-")
-	})
+	return matcher.NewPatternMatcher("exit-survey-{guild:u64}-{ticket:int}")
 }
 
 func (h *ExitSurveySubmitHandler) Properties() registry.Properties {
 	return registry.Properties{
-		Flags:   registry.SumFlags(registry.DMsAllowed),
-		Timeout: time.Second * 8,
+		Flags:            registry.SumFlags(registry.DMsAllowed),
+		Timeout:          time.Second * 8,
+		RequireSignature: true,
 	}
 }
 
-var exitSurveyPattern = regexp.MustCompile(`exit-survey-(\d+)-(\d+)`)
-
 func (h *ExitSurveySubmitHandler) Execute(cmd *cmdcontext.ModalContext) {
-	groups := exitSurveyPattern.FindStringSubmatch(cmd.Interaction.Data.CustomId)
-	if len(groups) != 3 {
-		return
-	}
-
 	ctx, cancel := context.WithTimeout(cmd.Context, time.Second*10)
 	defer cancel()
 
-	// Error may occur if guild ID in custom ID > max u64 size
-	guildId, err := strconv.ParseUint(groups[1], 10, 64)
-	if err != nil {
-		cmd.HandleError(err)
-		return
-	}
+	guildId := cmd.Params.Uint64("guild")
+	ticketId := cmd.Params.Int("ticket")
 
-	ticketId, err := strconv.Atoi(groups[2])
-	if err != nil {
-		cmd.HandleError(err)
-		return
-	}
-
-	// Get ticket
-	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, guildId)
-	if err != nil {
-		cmd.HandleError(err)
-		return
-	}
-
-	if ticket.UserId != cmd.InteractionUser().Id || ticket.GuildId != guildId || ticket.Id != ticketId {
-		return
-	}
-
-	feedbackEnabled, err := dbclient.Client.FeedbackEnabled.Get(ctx, guildId)
-	if err != nil {
-		cmd.HandleError(err)
-		return
-	}
-
-	if !feedbackEnabled {
-		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageFeedbackDisabled)
-		return
-	}
-
-	if ticket.PanelId == nil {
-		cmd.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
-		return
-	}
-
-	panel, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
-	if err != nil {
-		cmd.HandleError(err)
-		return
-	}
-
-	if panel.GuildId != guildId || panel.PanelId != *ticket.PanelId {
-		cmd.HandleError(fmt.Errorf("panel not found"))
-		return
-	}
-
-	if panel.ExitSurveyFormId == nil {
-		cmd.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
+	survey, ok := loadExitSurvey(ctx, cmd, guildId, ticketId)
+	if !ok {
 		return
 	}
 
-	form, ok, err := dbclient.Client.Forms.Get(ctx, *panel.ExitSurveyFormId)
-	if err != nil {
-		cmd.HandleError(err)
-		return
-	}
+	// Answers to select questions were given before the modal was opened
+	answers := make(map[string]string)
+	if cmd.State != nil {
+		var progress exitSurveyState
+		if err := cmd.State.Decode(&progress); err != nil {
+			cmd.HandleError(err)
+			return
+		}
 
-	if !ok {
-		cmd.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
-		return
+		if progress.GuildId == guildId && progress.TicketId == ticketId && progress.FormId == survey.Form.Id {
+			answers = progress.Answers
+		}
 	}
 
-	formInputs, err := dbclient.Client.FormInput.GetInputs(ctx, form.Id)
-	if err != nil {
-		cmd.HandleError(err)
-		return
-	}
+	for _, input := range survey.Inputs {
+		if survey.isSelect(input) {
+			continue
+		}
 
-	responses := make(map[int]string)
-	for _, input := range formInputs {
 		value, ok := cmd.GetInput(input.CustomId)
 		if ok {
-			responses[input.Id] = value
+			answers[input.CustomId] = value
 		}
 	}
 
-	if err := dbclient.Client.ExitSurveyResponses.AddResponses(ctx, guildId, ticketId, *panel.ExitSurveyFormId, responses); err != nil {
-		cmd.HandleError(err)
-		return
-	}
-
-	cmd.EditWithRaw(customisation.Green, "Success", "Thank you for your feedback!") // TODO: i18n
-
-	if err := addViewFeedbackButton(ctx, cmd, ticket); err != nil {
+	if err := submitExitSurvey(ctx, cmd, survey, answers); err != nil {
 		cmd.HandleError(err)
 		return
 	}
-}
-
-func addViewFeedbackButton(ctx context.Context, cmd *cmdcontext.ModalContext, ticket database.Ticket) error {
-	// Get archive message
-	settings, err := cmd.Settings()
-	if err != nil {
-		return err
-	}
-
-	closeMetadata, ok, err := dbclient.Client.CloseReason.Get(ctx, ticket.GuildId, ticket.Id)
-	if err != nil {
-		return err
-	}
-
-	var closedBy uint64
-	var reason *string
-	if ok {
-		reason = closeMetadata.Reason
 
-		if closeMetadata.ClosedBy != nil {
-			closedBy = *closeMetadata.ClosedBy
+	if cmd.State != nil {
+		if _, err := cmd.State.Delete(ctx); err != nil {
+			fmt.Print(err, cmd.ToErrorContext())
 		}
 	}
 
-	rating, ok, err := dbclient.Client.ServiceRatings.Get(ctx, ticket.GuildId, ticket.Id)
-	if err != nil {
-		return err
-	}
+	cmd.EditWithRaw(customisation.Green, "Success", "Thank you for your feedback!") // TODO: i18n
 
-	if !ok {
-		return fmt.Errorf("exit survey was completed, but no rating was found (%d:%d)", ticket.GuildId, ticket.Id)
+	if err := addViewFeedbackButton(ctx, cmd, survey.Ticket); err != nil {
+		cmd.HandleError(err)
+		return
 	}
-
-	return logic.EditGuildArchiveMessageIfExists(ctx, cmd.Worker(), ticket, settings, true, closedBy, reason, &rating)
 }
@@ -2,17 +2,23 @@ package handlers
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	database "github.com/jadevelopmentgrp/Tickets-Database"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/constants"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction/component"
 )
 
 type FormHandler struct{}
@@ -47,8 +53,8 @@ func (h *FormHandler) Execute(ctx *context.ModalContext) {
 			return
 		}
 
-		// blacklist check
-		blacklisted, err := ctx.IsBlacklisted(ctx)
+		// blacklist check - blacklisted users may still open the appeals panel
+		blacklisted, err := logic.IsBlacklistedFromPanel(ctx, ctx, panel)
 		if err != nil {
 			ctx.HandleError(err)
 			return
@@ -59,6 +65,23 @@ func (h *FormHandler) Execute(ctx *context.ModalContext) {
 			return
 		}
 
+		// Later pages of a form carry the answers to the previous pages in the component state
+		progress := formState{
+			PanelCustomId: panel.CustomId,
+			Answers:       make(map[string]string),
+		}
+
+		if ctx.State != nil {
+			if err := ctx.State.Decode(&progress); err != nil {
+				ctx.HandleError(err)
+				return
+			}
+
+			if progress.PanelCustomId != panel.CustomId {
+				return
+			}
+		}
+
 		inputs, err := dbclient.Client.FormInput.GetAllInputsByCustomId(ctx, ctx.GuildId())
 		if err != nil {
 			ctx.HandleError(err)
@@ -71,34 +94,223 @@ func (h *FormHandler) Execute(ctx *context.ModalContext) {
 				questionData, ok := inputs[input.CustomId]
 				if ok { // If form has changed, we can skip
 					formAnswers[questionData] = input.Value
+					progress.Answers[input.CustomId] = input.Value
 				}
 			}
 		}
 
 		// Validate user input
-		for question, answer := range formAnswers {
-			if !question.Required {
-				continue
-			}
+		invalid, err := logic.ValidateFormAnswers(ctx, formAnswers)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
 
-			// Check that users have not just pressed newline or space
-			isValid := false
-			for _, c := range answer {
-				if c != rune(' ') && c != rune('\n') {
-					isValid = true
-					break
-				}
-			}
+		if len(invalid) > 0 {
+			replyInvalidForm(ctx, progress, formAnswers, invalid)
+			return
+		}
 
-			if !isValid {
-				ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormMissingInput, question.Label)
+		// Questions on later pages may depend on the answers just given, so work out the pages again
+		var pages [][]database.FormInput
+		if panel.FormId != nil {
+			pages, err = logic.GetFormPages(ctx, formInputs(inputs, *panel.FormId), progress.Answers)
+			if err != nil {
+				ctx.HandleError(err)
 				return
 			}
 		}
 
+		if progress.Page+1 < len(pages) {
+			progress.Page++
+			replyFormContinue(ctx, progress, len(pages))
+			return
+		}
+
+		// Merge the answers from all pages, so that the ticket sees the whole form, leaving out questions whose
+		// conditions were not met
+		if pages != nil {
+			formAnswers = logic.FormPageAnswers(pages, progress.Answers)
+		}
+
+		if ctx.State != nil {
+			if _, err := ctx.State.Delete(ctx); err != nil {
+				fmt.Print(err, ctx.ToErrorContext())
+			}
+		}
+
 		ctx.Defer()
 		_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, formAnswers)
 
 		return
 	}
 }
+
+// formState holds a user's answers to a form while they move between its pages, or after an answer failed
+// validation, so that the form can be re-opened at the right page with their answers filled in
+type formState struct {
+	PanelCustomId string            `json:"panel_custom_id"`
+	Page          int               `json:"page"`
+	Answers       map[string]string `json:"answers"`
+}
+
+// formInputs returns the inputs belonging to a form
+func formInputs(inputs map[string]database.FormInput, formId int) []database.FormInput {
+	filtered := make([]database.FormInput, 0, len(inputs))
+	for _, input := range inputs {
+		if input.FormId == formId {
+			filtered = append(filtered, input)
+		}
+	}
+
+	return filtered
+}
+
+// saveFormState stores the user's progress through the form, reusing the existing token if there is one
+func saveFormState(ctx *context.ModalContext, progress formState) (string, error) {
+	if ctx.State != nil {
+		if err := ctx.State.Update(ctx, progress); err != nil {
+			return "", err
+		}
+
+		return ctx.State.Token, nil
+	}
+
+	return state.Save(ctx, ctx.GuildId(), ctx.UserId(), progress, state.DefaultExpiry)
+}
+
+// replyFormContinue sends a button to open the next page of the form, as Discord does not allow a modal to be sent in
+// response to a modal
+func replyFormContinue(ctx *context.ModalContext, progress formState, pageCount int) {
+	token, err := saveFormState(ctx, progress)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	e := utils.BuildEmbed(ctx, customisation.Green, i18n.TitleForm, i18n.MessageFormContinue, nil, progress.Page, pageCount)
+
+	components := []component.Component{
+		component.BuildActionRow(component.BuildButton(component.Button{
+			Label:    ctx.GetMessage(i18n.MessageFormContinueButton),
+			CustomId: state.Attach("form_resume", token),
+			Style:    component.ButtonStylePrimary,
+			Emoji:    utils.BuildEmoji("➡️"),
+		})),
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, components))
+}
+
+// replyInvalidForm lists the invalid answers, with a button to re-open the form with the user's answers filled in, as
+// Discord does not allow a modal to be sent in response to a modal
+func replyInvalidForm(ctx *context.ModalContext, progress formState, formAnswers map[database.FormInput]string, invalid map[database.FormInput]logic.FormValidationError) {
+	token, err := saveFormState(ctx, progress)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	inputs := make([]database.FormInput, 0, len(invalid))
+	for input := range invalid {
+		inputs = append(inputs, input)
+	}
+
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].Position < inputs[j].Position
+	})
+
+	e := utils.BuildEmbed(ctx, customisation.Red, i18n.Error, i18n.MessageFormValidationFailed, nil)
+	for _, input := range inputs {
+		validationError := invalid[input]
+
+		value := ctx.GetMessage(validationError.Message, validationError.Format...)
+		if answer := strings.TrimSpace(formAnswers[input]); answer != "" {
+			value = fmt.Sprintf("%s\n> %s", value, utils.EscapeMarkdown(utils.StringMax(strings.ReplaceAll(answer, "\n", " "), 200, "...")))
+		}
+
+		e.AddField(input.Label, value, false)
+	}
+
+	components := []component.Component{
+		component.BuildActionRow(component.BuildButton(component.Button{
+			Label:    ctx.GetMessage(i18n.MessageFormValidationEdit),
+			CustomId: state.Attach("form_resume", token),
+			Style:    component.ButtonStylePrimary,
+			Emoji:    utils.BuildEmoji("✏️"),
+		})),
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, components))
+}
+
+// FormResumeHandler re-opens a form at the page stored in the component state, either to continue to the next page
+// or to correct invalid answers
+type FormResumeHandler struct{}
+
+func (h *FormResumeHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "form_resume",
+	}
+}
+
+func (h *FormResumeHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed),
+		Timeout: time.Second * 3,
+	}
+}
+
+func (h *FormResumeHandler) Execute(ctx *context.ButtonContext) {
+	if ctx.State == nil {
+		return
+	}
+
+	var progress formState
+	if err := ctx.State.Decode(&progress); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	panel, ok, err := dbclient.Client.Panel.GetByCustomId(ctx, ctx.GuildId(), progress.PanelCustomId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// The panel or its form may have been removed since the answers were submitted
+	if !ok || panel.GuildId != ctx.GuildId() || panel.FormId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationFormChanged)
+		return
+	}
+
+	form, ok, err := dbclient.Client.Forms.Get(ctx, *panel.FormId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationFormChanged)
+		return
+	}
+
+	inputs, err := dbclient.Client.FormInput.GetInputs(ctx, form.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	pages, err := logic.GetFormPages(ctx, inputs, progress.Answers)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if progress.Page >= len(pages) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationFormChanged)
+		return
+	}
+
+	ctx.Modal(buildForm(panel, form, pages, progress.Page, progress.Answers, ctx.State.Token))
+}
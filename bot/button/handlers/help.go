@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/rxdn/gdl/objects/channel/embed"
+)
+
+type HelpCategoryHandler struct {
+	Registry cmdregistry.Registry
+}
+
+func (h *HelpCategoryHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "help_category",
+	}
+}
+
+func (h *HelpCategoryHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		Timeout: time.Second * 5,
+	}
+}
+
+func (h *HelpCategoryHandler) Execute(ctx *context.SelectMenuContext) {
+	if len(ctx.InteractionData.Values) == 0 {
+		return
+	}
+
+	category, err := strconv.Atoi(ctx.InteractionData.Values[0])
+	if err != nil {
+		return
+	}
+
+	e, components, err := logic.BuildHelpMessage(ctx, ctx, h.Registry, category, 0)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds:     []*embed.Embed{e},
+		Components: components,
+	})
+}
+
+type HelpPageHandler struct {
+	Registry cmdregistry.Registry
+}
+
+func (h *HelpPageHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("help_page_{category:int}_{page:int}")
+}
+
+func (h *HelpPageHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		Timeout: time.Second * 5,
+	}
+}
+
+func (h *HelpPageHandler) Execute(ctx *context.ButtonContext) {
+	category := ctx.Params.Int("category")
+
+	page := ctx.Params.Int("page")
+	if page < 0 {
+		return
+	}
+
+	e, components, err := logic.BuildHelpMessage(ctx, ctx, h.Registry, category, page)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds:     []*embed.Embed{e},
+		Components: components,
+	})
+}
@@ -9,20 +9,13 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 )
 
 type JoinThreadHandler struct{}
 
 func (h *JoinThreadHandler) Matcher() matcher.Matcher {
-	return &matcher.FuncMatcher{
-		Func: func(customId string) bool {
-			return strings.HasPrefix(customId, "join_thread_")
-		},
-	}
+	return matcher.NewPatternMatcher("join_thread_{ticket:int}")
 }
 
 func (h *JoinThreadHandler) Properties() registry.Properties {
@@ -32,16 +25,8 @@ func (h *JoinThreadHandler) Properties() registry.Properties {
 	}
 }
 
-var joinThreadPattern = regexp.MustCompile(`join_thread_(\d+)`)
-
 func (h *JoinThreadHandler) Execute(ctx *context.ButtonContext) {
-	groups := joinThreadPattern.FindStringSubmatch(ctx.InteractionData.CustomId)
-	if len(groups) < 2 {
-		return
-	}
-
-	// Errors are impossible
-	ticketId, _ := strconv.Atoi(groups[1])
+	ticketId := ctx.Params.Int("ticket")
 
 	// Get ticket
 	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, ctx.GuildId())
@@ -48,8 +48,8 @@ func (h *MultiPanelHandler) Execute(ctx *context.SelectMenuContext) {
 			return
 		}
 
-		// blacklist check
-		blacklisted, err := ctx.IsBlacklisted(ctx)
+		// blacklist check - blacklisted users may still open the appeals panel
+		blacklisted, err := logic.IsBlacklistedFromPanel(ctx, ctx, panel)
 		if err != nil {
 			ctx.HandleError(err)
 			return
@@ -80,10 +80,16 @@ func (h *MultiPanelHandler) Execute(ctx *context.SelectMenuContext) {
 				return
 			}
 
-			if len(inputs) == 0 { // Don't open a blank form
+			pages, err := logic.GetFormPages(ctx, inputs, nil)
+			if err != nil {
+				ctx.HandleError(err)
+				return
+			}
+
+			if len(pages) == 0 { // Don't open a blank form
 				_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil)
 			} else {
-				modal := buildForm(panel, form, inputs)
+				modal := buildForm(panel, form, pages, 0, nil, "")
 				ctx.Modal(modal)
 			}
 		}
@@ -1,148 +1,58 @@
 package handlers
 
 import (
-	"fmt"
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
-	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
-	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
-	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
-	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
-	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
-	"github.com/rxdn/gdl/objects/interaction"
-	"github.com/rxdn/gdl/objects/interaction/component"
 )
 
 type OpenSurveyHandler struct{}
 
 func (h *OpenSurveyHandler) Matcher() matcher.Matcher {
-	return matcher.NewFuncMatcher(func(customId string) bool {
-		return strings.HasPrefix(customId, "open-exit-survey-")
-	})
+	return matcher.NewPatternMatcher("open-exit-survey-{guild:u64}-{ticket:int}")
 }
 
 func (h *OpenSurveyHandler) Properties() registry.Properties {
 	return registry.Properties{
-		Flags:   registry.SumFlags(registry.DMsAllowed),
-		Timeout: time.Second * 3,
+		Flags:            registry.SumFlags(registry.DMsAllowed),
+		Timeout:          time.Second * 3,
+		RequireSignature: true,
 	}
 }
 
-var openSurveyPattern = regexp.MustCompile(`open-exit-survey-(\d+)-(\d+)`)
-
 func (h *OpenSurveyHandler) Execute(ctx *context.ButtonContext) {
-	groups := openSurveyPattern.FindStringSubmatch(ctx.InteractionData.CustomId)
-	if len(groups) != 3 {
-		return
-	}
-
-	// Error may occur if guild ID in custom ID > max u64 size
-	guildId, err := strconv.ParseUint(groups[1], 10, 64)
-	if err != nil {
-		ctx.HandleError(err)
-		return
-	}
-
-	ticketId, err := strconv.Atoi(groups[2])
-	if err != nil {
-		ctx.HandleError(err)
-		return
-	}
-
-	// Get ticket
-	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, guildId)
-	if err != nil {
-		ctx.HandleError(err)
-		return
-	}
-
-	if ticket.UserId != ctx.InteractionUser().Id || ticket.GuildId != guildId || ticket.Id != ticketId {
-		return
-	}
-
-	feedbackEnabled, err := dbclient.Client.FeedbackEnabled.Get(ctx, guildId)
-	if err != nil {
-		ctx.HandleError(err)
-		return
-	}
-
-	if !feedbackEnabled {
-		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFeedbackDisabled)
-		return
-	}
+	guildId := ctx.Params.Uint64("guild")
+	ticketId := ctx.Params.Int("ticket")
 
-	if ticket.PanelId == nil {
-		ctx.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
+	survey, ok := loadExitSurvey(ctx, ctx, guildId, ticketId)
+	if !ok {
 		return
 	}
 
-	panel, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
-	if err != nil {
-		ctx.HandleError(err)
+	if !survey.hasSelectQuestions() {
+		ctx.Modal(buildExitSurveyModal(survey, ""))
 		return
 	}
 
-	if panel.GuildId != guildId || panel.PanelId != *ticket.PanelId {
-		ctx.HandleError(fmt.Errorf("panel not found"))
-		return
+	// Select menus cannot be placed in a modal, so those questions are asked in a message first
+	progress := exitSurveyState{
+		GuildId:  guildId,
+		TicketId: ticketId,
+		FormId:   survey.Form.Id,
+		Answers:  make(map[string]string),
 	}
 
-	if panel.ExitSurveyFormId == nil {
-		ctx.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
-		return
-	}
-
-	form, ok, err := dbclient.Client.Forms.Get(ctx, *panel.ExitSurveyFormId)
+	token, err := state.Save(ctx, ctx.GuildId(), ctx.UserId(), progress, state.DefaultExpiry)
 	if err != nil {
 		ctx.HandleError(err)
 		return
 	}
 
-	if !ok {
-		ctx.ReplyRaw(customisation.Red, "Error", "The survey is no longer available for this ticket.") // TODO: i18n
-		return
-	}
-
-	formInputs, err := dbclient.Client.FormInput.GetInputs(ctx, form.Id)
-	if err != nil {
+	if _, err := ctx.ReplyWith(buildExitSurveySelectMessage(ctx, survey, progress, token)); err != nil {
 		ctx.HandleError(err)
 		return
 	}
-
-	components := make([]component.Component, len(formInputs))
-	for i, input := range formInputs {
-		var minLength, maxLength *uint32
-		if input.MinLength != nil && *input.MinLength > 0 {
-			minLength = utils.Ptr(uint32(*input.MinLength))
-		}
-
-		if input.MaxLength != nil {
-			maxLength = utils.Ptr(uint32(*input.MaxLength))
-		}
-
-		components[i] = component.BuildActionRow(component.BuildInputText(component.InputText{
-			Style:       component.TextStyleTypes(input.Style),
-			CustomId:    input.CustomId,
-			Label:       input.Label,
-			Placeholder: input.Placeholder,
-			MinLength:   minLength,
-			MaxLength:   maxLength,
-			Required:    utils.Ptr(input.Required),
-			Value:       nil,
-		}))
-	}
-
-	ctx.Modal(button.ResponseModal{
-		Data: interaction.ModalResponseData{
-			CustomId:   fmt.Sprintf("exit-survey-%d-%d", guildId, ticketId),
-			Title:      form.Title,
-			Components: components,
-		},
-	})
 }
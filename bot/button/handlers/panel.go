@@ -3,11 +3,13 @@ package handlers
 import (
 	"errors"
 	"fmt"
+	"unicode/utf8"
 
 	database "github.com/jadevelopmentgrp/Tickets-Database"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/constants"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
@@ -45,8 +47,8 @@ func (h *PanelHandler) Execute(ctx *context.ButtonContext) {
 			return
 		}
 
-		// blacklist check
-		blacklisted, err := ctx.IsBlacklisted(ctx)
+		// blacklist check - blacklisted users may still open the appeals panel
+		blacklisted, err := logic.IsBlacklistedFromPanel(ctx, ctx, panel)
 		if err != nil {
 			ctx.HandleError(err)
 			return
@@ -77,10 +79,16 @@ func (h *PanelHandler) Execute(ctx *context.ButtonContext) {
 				return
 			}
 
-			if len(inputs) == 0 { // Don't open a blank form
+			pages, err := logic.GetFormPages(ctx, inputs, nil)
+			if err != nil {
+				ctx.HandleError(err)
+				return
+			}
+
+			if len(pages) == 0 { // Don't open a blank form
 				_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil)
 			} else {
-				modal := buildForm(panel, form, inputs)
+				modal := buildForm(panel, form, pages, 0, nil, "")
 				ctx.Modal(modal)
 			}
 		}
@@ -89,9 +97,18 @@ func (h *PanelHandler) Execute(ctx *context.ButtonContext) {
 	}
 }
 
-func buildForm(panel database.Panel, form database.Form, inputs []database.FormInput) button.ResponseModal {
-	components := make([]component.Component, len(inputs))
-	for i, input := range inputs {
+// buildForm builds the modal for a page of a panel's form. values pre-fills the inputs, keyed by custom ID, and may be
+// nil. token is the state token holding the answers to the previous pages, and is empty when opening the first page.
+func buildForm(panel database.Panel, form database.Form, pages [][]database.FormInput, page int, values map[string]string, token string) button.ResponseModal {
+	pageInputs := pages[page]
+
+	components := make([]component.Component, len(pageInputs))
+	for i, input := range pageInputs {
+		var value *string
+		if tmp, ok := values[input.CustomId]; ok {
+			value = &tmp
+		}
+
 		var minLength, maxLength *uint32
 		if input.MinLength != nil && *input.MinLength > 0 {
 			minLength = utils.Ptr(uint32(*input.MinLength))
@@ -109,13 +126,26 @@ func buildForm(panel database.Panel, form database.Form, inputs []database.FormI
 			MinLength:   minLength,
 			MaxLength:   maxLength,
 			Required:    utils.Ptr(input.Required),
+			Value:       value,
 		}))
 	}
 
+	title := form.Title
+	if len(pages) > 1 {
+		// Modal titles are limited to 45 characters, not bytes, and the title may contain multi-byte characters
+		suffix := fmt.Sprintf(" (%d/%d)", page+1, len(pages))
+		title = utils.TruncateRunes(form.Title, 45-utf8.RuneCountInString(suffix), "") + suffix
+	}
+
+	customId := fmt.Sprintf("form_%s", panel.CustomId)
+	if token != "" {
+		customId = state.Attach(customId, token)
+	}
+
 	return button.ResponseModal{
 		Data: interaction.ModalResponseData{
-			CustomId:   fmt.Sprintf("form_%s", panel.CustomId),
-			Title:      form.Title,
+			CustomId:   customId,
+			Title:      title,
 			Components: components,
 		},
 	}
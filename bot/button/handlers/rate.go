@@ -2,19 +2,19 @@ package handlers
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/signing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction/component"
 )
@@ -22,48 +22,45 @@ import (
 type RateHandler struct{}
 
 func (h *RateHandler) Matcher() matcher.Matcher {
-	return &matcher.FuncMatcher{
-		Func: func(customId string) bool {
-			return strings.HasPrefix(customId, "rate_")
-		},
-	}
+	return matcher.NewPatternMatcher("rate_{scale:str}_{guild:u64}_{ticket:int}_{score:int}")
 }
 
 func (h *RateHandler) Properties() registry.Properties {
 	return registry.Properties{
-		Flags:   registry.SumFlags(registry.DMsAllowed, registry.CanEdit),
-		Timeout: time.Second * 10,
+		Flags:            registry.SumFlags(registry.DMsAllowed, registry.CanEdit),
+		Timeout:          time.Second * 10,
+		RequireSignature: true,
 	}
 }
 
-var ratePattern = regexp.MustCompile(`rate_(\d+)_(\d+)_([1-5])`)
-
 func (h *RateHandler) Execute(ctx *cmdcontext.ButtonContext) {
-	groups := ratePattern.FindStringSubmatch(ctx.InteractionData.CustomId)
-	if len(groups) < 4 {
-		return
+	guildId := ctx.Params.Uint64("guild")
+	ticketId := ctx.Params.Int("ticket")
+
+	// The scale is carried in the custom ID, rather than read from the settings, as the guild may have changed scale
+	// since the ticket was closed
+	scale := dbclient.RatingScale(ctx.Params.String("scale"))
+	if scale == "" {
+		scale = dbclient.RatingScaleStars
 	}
 
-	// Error may occur if guild ID in custom ID > max u64 size
-	guildId, err := strconv.ParseUint(groups[1], 10, 64)
-	if err != nil {
-		ctx.HandleError(err)
+	if !logic.IsValidRatingScale(scale) {
 		return
 	}
 
-	ticketId, err := strconv.Atoi(groups[2])
-	if err != nil {
-		ctx.HandleError(err)
+	score := ctx.Params.Int("score")
+	if score < 1 || score > logic.RatingScaleMax(scale) {
 		return
 	}
 
-	ratingRaw, err := strconv.Atoi(groups[3])
-	if err != nil {
-		ctx.HandleError(err)
-		return
+	rating := dbclient.FeedbackRating{
+		GuildId:  guildId,
+		TicketId: ticketId,
+		Scale:    scale,
+		Score:    score,
 	}
 
-	rating := uint8(ratingRaw)
+	stars := logic.RatingToStars(scale, score)
 
 	// Get ticket
 	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, guildId)
@@ -87,12 +84,44 @@ func (h *RateHandler) Execute(ctx *cmdcontext.ButtonContext) {
 		return
 	}
 
-	if err := dbclient.Client.ServiceRatings.Set(ctx, guildId, ticketId, rating); err != nil {
+	if err := dbclient.Client.ServiceRatings.Set(ctx, guildId, ticketId, stars); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := dbclient.Local.FeedbackRatings.Set(ctx, rating); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := webhooks.Dispatch(ctx, guildId, ticketId, webhooks.EventRated, ctx.UserId(), webhooks.RatingData{
+		Rating: stars,
+		Scale:  string(scale),
+		Score:  score,
+	}); err != nil {
+		fmt.Print(err, ctx.ToErrorContext())
+	}
+
+	feedbackSettings, err := dbclient.Local.FeedbackSettings.Get(ctx, guildId)
+	if err != nil {
 		ctx.HandleError(err)
 		return
 	}
 
+	askReason := logic.ShouldAskRatingReason(feedbackSettings, scale, score)
+
+	var buttons []component.Component
+	if askReason {
+		buttons = append(buttons, component.BuildButton(component.Button{
+			Label:    ctx.GetMessage(i18n.MessageFeedbackReasonButton),
+			CustomId: signing.Sign(fmt.Sprintf("rate-reason-%d-%d", guildId, ticketId)),
+			Style:    component.ButtonStyleSecondary,
+			Emoji:    utils.BuildEmoji("💬"),
+		}))
+	}
+
 	// Exit survey
+	var hasSurvey bool
 	if ticket.PanelId != nil {
 		panel, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
 		if err != nil {
@@ -101,23 +130,30 @@ func (h *RateHandler) Execute(ctx *cmdcontext.ButtonContext) {
 		}
 
 		if panel.ExitSurveyFormId != nil {
-			row := component.BuildActionRow(component.BuildButton(component.Button{
+			hasSurvey = true
+
+			surveyButton := component.BuildButton(component.Button{
 				Label:    "Complete survey",
-				CustomId: fmt.Sprintf("open-exit-survey-%d-%d", guildId, ticketId),
+				CustomId: signing.Sign(fmt.Sprintf("open-exit-survey-%d-%d", guildId, ticketId)),
 				Style:    component.ButtonStylePrimary,
 				Emoji:    utils.BuildEmoji("🖊️"),
-			}))
+			})
+
+			buttons = append(buttons, surveyButton)
 
 			editData := command.MessageIntoMessageResponse(ctx.Interaction.Message)
-			if len(ctx.Interaction.Message.Components) == 1 {
-				editData.Components = append(editData.Components, row)
+			if !hasButton(ctx.Interaction.Message.Components, "open-exit-survey-") {
+				editData.Components = append(editData.Components, component.BuildActionRow(surveyButton))
 				ctx.Edit(editData)
 			}
-
-			ctx.ReplyRawWithComponents(customisation.Green, "Thank you!", "Your feedback has been recorded. Click the button below to fill in a short survey.", row) // TODO: i18n
-		} else {
-			ctx.Reply(customisation.Green, i18n.Success, i18n.MessageFeedbackSuccess)
 		}
+	}
+
+	if askReason {
+		e := utils.BuildEmbed(ctx, customisation.Green, i18n.Success, i18n.MessageFeedbackReasonPrompt, nil)
+		ctx.ReplyWithEmbedAndComponents(e, utils.Slice(component.BuildActionRow(buttons...)))
+	} else if hasSurvey {
+		ctx.ReplyRawWithComponents(customisation.Green, "Thank you!", "Your feedback has been recorded. Click the button below to fill in a short survey.", component.BuildActionRow(buttons...)) // TODO: i18n
 	} else {
 		ctx.Reply(customisation.Green, i18n.Success, i18n.MessageFeedbackSuccess)
 	}
@@ -155,3 +191,32 @@ func (h *RateHandler) Execute(ctx *cmdcontext.ButtonContext) {
 		ctx.HandleError(err)
 	}
 }
+
+// LegacyRateHandler handles the rating buttons on close messages sent before rating scales were configurable, which
+// are always 1-5 stars. It keeps the signature requirement of RateHandler: the legacy buttons were sent before custom
+// IDs were signed, so they are only accepted during the unsigned grace period.
+type LegacyRateHandler struct {
+	RateHandler
+}
+
+func (h *LegacyRateHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("rate_{guild:u64}_{ticket:int}_{score:int}")
+}
+
+// hasButton returns whether any of the rows contains a button whose custom ID starts with the prefix
+func hasButton(rows []component.Component, prefix string) bool {
+	for _, row := range rows {
+		actionRow, ok := row.ComponentData.(component.ActionRow)
+		if !ok {
+			continue
+		}
+
+		for _, c := range actionRow.Components {
+			if button, ok := c.ComponentData.(component.Button); ok && strings.HasPrefix(button.CustomId, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
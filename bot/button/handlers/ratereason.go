@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/signing"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+// RateReasonHandler opens a modal asking the user why they gave a low rating
+type RateReasonHandler struct{}
+
+func (h *RateReasonHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("rate-reason-{guild:u64}-{ticket:int}")
+}
+
+func (h *RateReasonHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:            registry.SumFlags(registry.DMsAllowed),
+		Timeout:          time.Second * 3,
+		RequireSignature: true,
+	}
+}
+
+func (h *RateReasonHandler) Execute(ctx *context.ButtonContext) {
+	guildId := ctx.Params.Uint64("guild")
+	ticketId := ctx.Params.Int("ticket")
+
+	ctx.Modal(button.ResponseModal{
+		Data: interaction.ModalResponseData{
+			CustomId: signing.Sign(fmt.Sprintf("rate-reason-submit-%d-%d", guildId, ticketId)),
+			Title:    i18n.MessageFeedbackReasonButton.GetFromGuild(guildId),
+			Components: []component.Component{
+				component.BuildActionRow(component.BuildInputText(component.InputText{
+					Style:     component.TextStyleParagraph,
+					CustomId:  "reason",
+					Label:     i18n.Reason.GetFromGuild(guildId),
+					MinLength: nil,
+					MaxLength: utils.Ptr(uint32(1024)),
+					Required:  utils.Ptr(true),
+				})),
+			},
+		},
+	})
+}
+
+// RateReasonSubmitHandler stores the reason given for a rating
+type RateReasonSubmitHandler struct{}
+
+func (h *RateReasonSubmitHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("rate-reason-submit-{guild:u64}-{ticket:int}")
+}
+
+func (h *RateReasonSubmitHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:            registry.SumFlags(registry.DMsAllowed),
+		Timeout:          time.Second * 5,
+		RequireSignature: true,
+	}
+}
+
+func (h *RateReasonSubmitHandler) Execute(ctx *context.ModalContext) {
+	guildId := ctx.Params.Uint64("guild")
+	ticketId := ctx.Params.Int("ticket")
+
+	reason, ok := ctx.GetInput("reason")
+	reason = strings.TrimSpace(reason)
+	if !ok || reason == "" {
+		return
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, guildId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.UserId != ctx.InteractionUser().Id || ticket.GuildId != guildId || ticket.Id != ticketId {
+		return
+	}
+
+	feedbackEnabled, err := dbclient.Client.FeedbackEnabled.Get(ctx, guildId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !feedbackEnabled {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFeedbackDisabled)
+		return
+	}
+
+	reason = utils.StringMax(reason, 1024)
+
+	ok, err = dbclient.Local.FeedbackRatings.SetReason(ctx, guildId, ticketId, reason)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFeedbackReasonNotRated)
+		return
+	}
+
+	rating, err := logic.GetFeedbackRating(ctx, guildId, ticketId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if rating != nil {
+		if err := webhooks.Dispatch(ctx, guildId, ticketId, webhooks.EventRatingReason, ctx.UserId(), webhooks.RatingData{
+			Rating: logic.RatingToStars(rating.Scale, rating.Score),
+			Scale:  string(rating.Scale),
+			Score:  rating.Score,
+			Reason: &reason,
+		}); err != nil {
+			fmt.Print(err, ctx.ToErrorContext())
+		}
+	}
+
+	ctx.Reply(customisation.Green, i18n.Success, i18n.MessageFeedbackReasonSuccess)
+}
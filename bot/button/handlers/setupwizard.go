@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+)
+
+type SetupWizardButtonHandler struct{}
+
+func (h *SetupWizardButtonHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("setupwizard_{action:str}")
+}
+
+func (h *SetupWizardButtonHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Admin,
+		Timeout:         time.Second * 30,
+	}
+}
+
+func (h *SetupWizardButtonHandler) Execute(ctx *context.ButtonContext) {
+	wizard, ok := loadSetupWizard(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	switch ctx.Params.String("action") {
+	case "channels":
+		wizard.SetMode(false)
+	case "threads":
+		wizard.SetMode(true)
+	case "back":
+		wizard.Back()
+	case "next":
+		wizard.Next()
+	case "previouspage":
+		wizard.PreviousPage()
+	case "nextpage":
+		wizard.NextPage()
+	case "cancel":
+		if _, err := ctx.State.Delete(ctx); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Edit(command.NewEphemeralEmbedMessageResponse(utils.BuildEmbed(ctx, customisation.Red, i18n.TitleSetup, i18n.SetupWizardCancelled, nil)))
+		return
+	case "confirm":
+		if !wizard.IsSummary() {
+			return
+		}
+
+		// Delete the state first, so that the wizard cannot be applied twice. If another click of the button has
+		// already deleted it, that click applies the wizard instead.
+		deleted, err := ctx.State.Delete(ctx)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !deleted {
+			return
+		}
+
+		summary, err := logic.CompleteSetupWizard(ctx, ctx, wizard)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		e := utils.BuildEmbedRaw(ctx.GetColour(customisation.Green), ctx.GetMessage(i18n.TitleSetup), summary, nil)
+		ctx.Edit(command.NewEphemeralEmbedMessageResponse(e))
+		return
+	default:
+		return
+	}
+
+	updateSetupWizard(ctx, ctx.State, wizard)
+}
+
+type SetupWizardSelectHandler struct{}
+
+func (h *SetupWizardSelectHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "setupwizard_select",
+	}
+}
+
+func (h *SetupWizardSelectHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Admin,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (h *SetupWizardSelectHandler) Execute(ctx *context.SelectMenuContext) {
+	if len(ctx.InteractionData.Values) == 0 {
+		return
+	}
+
+	wizard, ok := loadSetupWizard(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	if !wizard.Select(ctx.InteractionData.Values[0]) {
+		return
+	}
+
+	updateSetupWizard(ctx, ctx.State, wizard)
+}
+
+// loadSetupWizard decodes the wizard state. The state store has already checked that the wizard belongs to the user.
+func loadSetupWizard(ctx cmdregistry.CommandContext, s *state.State) (logic.SetupWizardState, bool) {
+	if s == nil {
+		return logic.SetupWizardState{}, false
+	}
+
+	var wizard logic.SetupWizardState
+	if err := s.Decode(&wizard); err != nil {
+		ctx.HandleError(err)
+		return logic.SetupWizardState{}, false
+	}
+
+	return wizard, true
+}
+
+// updateSetupWizard builds the message before storing the state, so that the page is stored as clamped by the build
+func updateSetupWizard(ctx editableContext, s *state.State, wizard logic.SetupWizardState) {
+	e, components, err := logic.BuildSetupWizardMessage(ctx, s.Token, &wizard)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := s.Update(ctx, wizard); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds:     []*embed.Embed{e},
+		Components: components,
+	})
+}
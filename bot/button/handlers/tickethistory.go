@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/rxdn/gdl/objects/channel/embed"
+)
+
+type TicketHistoryPageHandler struct{}
+
+func (h *TicketHistoryPageHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("tickethistory_page_{page:int}")
+}
+
+func (h *TicketHistoryPageHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         time.Second * 10,
+	}
+}
+
+func (h *TicketHistoryPageHandler) Execute(ctx *context.ButtonContext) {
+	history, ok := loadTicketHistory(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	history.Page = ctx.Params.Int("page")
+	if history.Page < 0 {
+		return
+	}
+
+	updateTicketHistory(ctx, ctx.State, history)
+}
+
+type TicketHistoryPanelHandler struct{}
+
+func (h *TicketHistoryPanelHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "tickethistory_panel",
+	}
+}
+
+func (h *TicketHistoryPanelHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         time.Second * 10,
+	}
+}
+
+func (h *TicketHistoryPanelHandler) Execute(ctx *context.SelectMenuContext) {
+	if len(ctx.InteractionData.Values) == 0 {
+		return
+	}
+
+	history, ok := loadTicketHistory(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	if value := ctx.InteractionData.Values[0]; value == logic.TicketHistoryAllPanels {
+		history.PanelId = nil
+	} else {
+		panelId, err := strconv.Atoi(value)
+		if err != nil {
+			return
+		}
+
+		history.PanelId = &panelId
+	}
+
+	history.Page = 0
+	updateTicketHistory(ctx, ctx.State, history)
+}
+
+func loadTicketHistory(ctx cmdregistry.CommandContext, s *state.State) (logic.TicketHistoryState, bool) {
+	if s == nil {
+		return logic.TicketHistoryState{}, false
+	}
+
+	var history logic.TicketHistoryState
+	if err := s.Decode(&history); err != nil {
+		ctx.HandleError(err)
+		return logic.TicketHistoryState{}, false
+	}
+
+	return history, true
+}
+
+func updateTicketHistory(ctx editableContext, s *state.State, history logic.TicketHistoryState) {
+	if err := s.Update(ctx, history); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	e, components, err := logic.BuildTicketHistoryMessage(ctx, ctx, s.Token, history)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds:     []*embed.Embed{e},
+		Components: components,
+	})
+}
@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/constants"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+type TicketListPageHandler struct{}
+
+func (h *TicketListPageHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("ticketlist_page_{page:int}")
+}
+
+func (h *TicketListPageHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         time.Second * 10,
+	}
+}
+
+func (h *TicketListPageHandler) Execute(ctx *context.ButtonContext) {
+	list, ok := loadTicketList(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	list.Page = ctx.Params.Int("page")
+	if list.Page < 0 {
+		return
+	}
+
+	if err := ctx.State.Update(ctx, list); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	updateTicketList(ctx, ctx.State, list)
+}
+
+type TicketListClaimHandler struct{}
+
+func (h *TicketListClaimHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("ticketlist_claim_{ticket:int}")
+}
+
+func (h *TicketListClaimHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         constants.TimeoutOpenTicket,
+	}
+}
+
+func (h *TicketListClaimHandler) Execute(ctx *context.ButtonContext) {
+	list, ok := loadTicketList(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, ctx.Params.Int("ticket"), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// The ticket may have been closed since the list was built, in which case it will disappear when re-rendered
+	if ticket.Id == 0 || !ticket.Open || ticket.ChannelId == nil {
+		updateTicketList(ctx, ctx.State, list)
+		return
+	}
+
+	if ticket.IsThread {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimThread)
+		return
+	}
+
+	claimedBy, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if claimedBy != 0 {
+		updateTicketList(ctx, ctx.State, list)
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTicketListAlreadyClaimed, ticket.Id, claimedBy)
+		return
+	}
+
+	// Act on the ticket as if from the dashboard, so that messages are sent to the ticket channel rather than the list
+	ticketCtx := context.NewDashboardContext(ctx.Context, ctx.Worker(), ticket.GuildId, *ticket.ChannelId, ctx.UserId())
+	if err := logic.ClaimTicket(ctx.Context, &ticketCtx, ticket, ctx.UserId()); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ticketCtx.ReplyPermanent(customisation.Green, i18n.TitleClaimed, i18n.MessageClaimed, fmt.Sprintf("<@%d>", ctx.UserId()))
+	updateTicketList(ctx, ctx.State, list)
+}
+
+type TicketListCloseHandler struct{}
+
+func (h *TicketListCloseHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("ticketlist_close_{ticket:int}")
+}
+
+func (h *TicketListCloseHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         constants.TimeoutCloseTicket,
+	}
+}
+
+func (h *TicketListCloseHandler) Execute(ctx *context.ButtonContext) {
+	list, ok := loadTicketList(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, ctx.Params.Int("ticket"), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// The ticket may have been closed since the list was built, in which case it will disappear when re-rendered
+	if ticket.Id == 0 || !ticket.Open || ticket.ChannelId == nil {
+		updateTicketList(ctx, ctx.State, list)
+		return
+	}
+
+	closeConfirmation, err := dbclient.Client.CloseConfirmation.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !closeConfirmation {
+		closeListedTicket(ctx, ticket)
+		updateTicketList(ctx, ctx.State, list)
+		return
+	}
+
+	// The confirmation replaces the list, rather than being sent as a reply, so that the list can be re-rendered
+	// once the ticket is closed. Cancelling returns to the same page.
+	confirmEmbed := utils.BuildEmbed(ctx, customisation.Green, i18n.TitleCloseConfirmation, i18n.MessageCloseConfirmation, nil)
+	confirmEmbed.SetAuthor(ctx.InteractionUser().Username, "", utils.Ptr(ctx.InteractionUser()).AvatarUrl(256))
+
+	ctx.Edit(command.MessageResponse{
+		Embeds: []*embed.Embed{confirmEmbed},
+		Components: []component.Component{
+			component.BuildActionRow(
+				component.BuildButton(component.Button{
+					Label:    ctx.GetMessage(i18n.TitleClose),
+					CustomId: state.Attach(fmt.Sprintf("ticketlist_closeconfirm_%d", ticket.Id), ctx.State.Token),
+					Style:    component.ButtonStylePrimary,
+					Emoji:    utils.BuildEmoji("✔️"),
+				}),
+				component.BuildButton(component.Button{
+					Label:    ctx.GetMessage(i18n.MessageTicketListCancel),
+					CustomId: state.Attach(fmt.Sprintf("ticketlist_page_%d", list.Page), ctx.State.Token),
+					Style:    component.ButtonStyleSecondary,
+				}),
+			),
+		},
+	})
+}
+
+type TicketListCloseConfirmHandler struct{}
+
+func (h *TicketListCloseConfirmHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("ticketlist_closeconfirm_{ticket:int}")
+}
+
+func (h *TicketListCloseConfirmHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         constants.TimeoutCloseTicket,
+	}
+}
+
+func (h *TicketListCloseConfirmHandler) Execute(ctx *context.ButtonContext) {
+	list, ok := loadTicketList(ctx, ctx.State)
+	if !ok {
+		return
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, ctx.Params.Int("ticket"), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id != 0 && ticket.Open && ticket.ChannelId != nil {
+		closeListedTicket(ctx, ticket)
+	}
+
+	updateTicketList(ctx, ctx.State, list)
+}
+
+// closeListedTicket closes the ticket as if from the dashboard, so that messages are sent to the ticket channel rather
+// than the list
+func closeListedTicket(ctx *context.ButtonContext, ticket database.Ticket) {
+	ticketCtx := context.NewDashboardContext(ctx.Context, ctx.Worker(), ticket.GuildId, *ticket.ChannelId, ctx.UserId())
+	logic.CloseTicket(ctx.Context, &ticketCtx, nil, false)
+}
+
+func loadTicketList(ctx *context.ButtonContext, s *state.State) (logic.TicketListState, bool) {
+	if s == nil {
+		return logic.TicketListState{}, false
+	}
+
+	var list logic.TicketListState
+	if err := s.Decode(&list); err != nil {
+		ctx.HandleError(err)
+		return logic.TicketListState{}, false
+	}
+
+	// The list may have been filtered to the user's own view, so only they may act on it
+	if list.UserId != ctx.UserId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTicketListNotOwner)
+		return logic.TicketListState{}, false
+	}
+
+	return list, true
+}
+
+func updateTicketList(ctx *context.ButtonContext, s *state.State, list logic.TicketListState) {
+	e, components, err := logic.BuildTicketListMessage(ctx, ctx, s.Token, list)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds:     []*embed.Embed{e},
+		Components: components,
+	})
+}
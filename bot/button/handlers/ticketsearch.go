@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/rxdn/gdl/objects/channel/embed"
+)
+
+type TicketSearchPageHandler struct{}
+
+func (h *TicketSearchPageHandler) Matcher() matcher.Matcher {
+	return matcher.NewPatternMatcher("ticketsearch_page_{page:int}")
+}
+
+func (h *TicketSearchPageHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         time.Second * 10,
+	}
+}
+
+func (h *TicketSearchPageHandler) Execute(ctx *context.ButtonContext) {
+	if ctx.State == nil {
+		return
+	}
+
+	var search logic.TicketSearchState
+	if err := ctx.State.Decode(&search); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	search.Page = ctx.Params.Int("page")
+	if search.Page < 0 {
+		return
+	}
+
+	if err := ctx.State.Update(ctx, search); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	e, components, err := logic.BuildTicketSearchMessage(ctx, ctx, ctx.State.Token, search)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds:     []*embed.Embed{e},
+		Components: components,
+	})
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+)
+
+type UnblacklistHandler struct{}
+
+func (h *UnblacklistHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "unblacklist",
+	}
+}
+
+func (h *UnblacklistHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed),
+		PermissionLevel: permission.Support,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (h *UnblacklistHandler) Execute(ctx *context.ButtonContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if ticket.UserId == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	userId, isAppeal, err := dbclient.Local.BlacklistAppeals.Get(ctx, ctx.GuildId(), ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !isAppeal {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageBlacklistAppealNotAppeal)
+		return
+	}
+
+	// The user may also be blacklisted through one of their roles, which we can't lift on a per-user basis. Check this
+	// first, so that the user's own entry is left in place when unblacklisting them would have no effect.
+	member, err := ctx.Worker().GetGuildMember(ctx.GuildId(), userId)
+	if err == nil {
+		roleBlacklisted, err := dbclient.Client.RoleBlacklist.IsAnyBlacklisted(ctx, ctx.GuildId(), member.Roles)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if roleBlacklisted {
+			ctx.Reply(customisation.Red, i18n.TitleUnblacklist, i18n.MessageBlacklistAppealRoleBlacklist, userId)
+			return
+		}
+	}
+
+	isBlacklisted, err := dbclient.Client.Blacklist.IsBlacklisted(ctx, ctx.GuildId(), userId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !isBlacklisted {
+		ctx.Reply(customisation.Red, i18n.TitleUnblacklist, i18n.MessageBlacklistAppealNotBlacklisted, userId)
+		return
+	}
+
+	if err := dbclient.Client.Blacklist.Remove(ctx, ctx.GuildId(), userId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleUnblacklist, i18n.MessageBlacklistAppealSuccess, userId, ctx.UserId())
+}
@@ -2,9 +2,6 @@ package handlers
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
@@ -20,11 +17,7 @@ import (
 type ViewStaffHandler struct{}
 
 func (h *ViewStaffHandler) Matcher() matcher.Matcher {
-	return &matcher.FuncMatcher{
-		Func: func(customId string) bool {
-			return strings.HasPrefix(customId, "viewstaff_")
-		},
-	}
+	return matcher.NewPatternMatcher("viewstaff_{page:int}")
 }
 
 func (h *ViewStaffHandler) Properties() registry.Properties {
@@ -34,19 +27,8 @@ func (h *ViewStaffHandler) Properties() registry.Properties {
 	}
 }
 
-var viewStaffPattern = regexp.MustCompile(`viewstaff_(\d+)`)
-
 func (h *ViewStaffHandler) Execute(ctx *context.ButtonContext) {
-	groups := viewStaffPattern.FindStringSubmatch(ctx.InteractionData.CustomId)
-	if len(groups) < 2 {
-		return
-	}
-
-	page, err := strconv.Atoi(groups[1])
-	if err != nil {
-		return
-	}
-
+	page := ctx.Params.Int("page")
 	if page < 0 {
 		return
 	}
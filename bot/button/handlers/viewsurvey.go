@@ -1,9 +1,6 @@
 package handlers
 
 import (
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
@@ -21,39 +18,21 @@ import (
 type ViewSurveyHandler struct{}
 
 func (h *ViewSurveyHandler) Matcher() matcher.Matcher {
-	return matcher.NewFuncMatcher(func(customId string) bool {
-		return strings.HasPrefix(customId, "view-survey-")
-	})
+	return matcher.NewPatternMatcher("view-survey-{guild:u64}-{ticket:int}")
 }
 
 func (h *ViewSurveyHandler) Properties() registry.Properties {
 	return registry.Properties{
-		Flags:           registry.SumFlags(registry.GuildAllowed),
-		PermissionLevel: permission.Support,
-		Timeout:         time.Second * 5,
+		Flags:            registry.SumFlags(registry.GuildAllowed),
+		PermissionLevel:  permission.Support,
+		Timeout:          time.Second * 5,
+		RequireSignature: true,
 	}
 }
 
-var viewSurveyPattern = regexp.MustCompile(`view-survey-(\d+)-(\d+)`)
-
 func (h *ViewSurveyHandler) Execute(ctx *context.ButtonContext) {
-	groups := viewSurveyPattern.FindStringSubmatch(ctx.InteractionData.CustomId)
-	if len(groups) != 3 {
-		return
-	}
-
-	// Error may occur if guild ID in custom ID > max u64 size
-	guildId, err := strconv.ParseUint(groups[1], 10, 64)
-	if err != nil {
-		ctx.HandleError(err)
-		return
-	}
-
-	ticketId, err := strconv.Atoi(groups[2])
-	if err != nil {
-		ctx.HandleError(err)
-		return
-	}
+	guildId := ctx.Params.Uint64("guild")
+	ticketId := ctx.Params.Int("ticket")
 
 	// Get ticket
 	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, guildId)
@@ -2,6 +2,7 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,9 +11,13 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/blacklist"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/signing"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
 	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/objects/interaction/component"
@@ -66,9 +71,20 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 		return false
 	}
 
+	// Blacklisted users may still open a ticket from the appeals panel, which the panel handlers check for themselves
 	if userBlacklisted {
-		cc.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
-		return false
+		isAppealPanel, err := isAppealPanelInteraction(lookupCtx, data)
+		if err != nil {
+			fmt.Print(err, data.GuildId.Value, data.ChannelId)
+
+			cc.ReplyRaw(customisation.Red, "Error", fmt.Sprintf("An error occurred while processing this request."))
+			return false
+		}
+
+		if !isAppealPanel {
+			cc.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
+			return false
+		}
 	}
 
 	checkCtx, cancel := context.WithTimeout(ctx, time.Second*2)
@@ -76,11 +92,23 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 
 	switch data.Data.Type() {
 	case component.ComponentButton:
-		handler := manager.MatchButton(data.Data.AsButton().CustomId)
+		customId, failure := verifyCustomId(data.Data.AsButton().CustomId, manager.buttonRequiresSignature)
+		if failure != "" {
+			rejectCustomId(cc, "button", failure)
+			return false
+		}
+
+		customId, stateToken, _ := state.Detach(customId)
+
+		handler, params := manager.MatchButton(customId)
 		if handler == nil {
 			return false
 		}
 
+		componentCtx := cc.(*cmdcontext.ButtonContext)
+		componentCtx.InteractionData.CustomId = customId
+		componentCtx.Params = params
+
 		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handler.Properties())
 		if shouldExecute {
 			go func() {
@@ -92,17 +120,36 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 				cc.Context, cancel = context.WithTimeout(cc.Context, handler.Properties().Timeout)
 				defer cancel()
 
+				componentState, ok := loadState(cc, cc, stateToken)
+				if !ok {
+					return
+				}
+
+				cc.State = componentState
+
 				handler.Execute(cc)
 			}()
 		}
 
 		return canEdit
 	case component.ComponentSelectMenu:
-		handler := manager.MatchSelect(data.Data.AsSelectMenu().CustomId)
+		customId, failure := verifyCustomId(data.Data.AsSelectMenu().CustomId, manager.selectRequiresSignature)
+		if failure != "" {
+			rejectCustomId(cc, "select_menu", failure)
+			return false
+		}
+
+		customId, stateToken, _ := state.Detach(customId)
+
+		handler, params := manager.MatchSelect(customId)
 		if handler == nil {
 			return false
 		}
 
+		componentCtx := cc.(*cmdcontext.SelectMenuContext)
+		componentCtx.InteractionData.CustomId = customId
+		componentCtx.Params = params
+
 		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handler.Properties())
 		if shouldExecute {
 			go func() {
@@ -114,6 +161,13 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 				cc.Context, cancel = context.WithTimeout(cc.Context, handler.Properties().Timeout)
 				defer cancel()
 
+				componentState, ok := loadState(cc, cc, stateToken)
+				if !ok {
+					return
+				}
+
+				cc.State = componentState
+
 				handler.Execute(cc)
 			}()
 		}
@@ -151,3 +205,85 @@ func doPropertiesChecks(ctx context.Context, guildId uint64, cmd cmdregistry.Com
 
 	return true, properties.HasFlag(registry.CanEdit)
 }
+
+// verifyCustomId removes the signature from a custom ID, if the handler it belongs to requires one. If the handler
+// requires a signature and the custom ID was not correctly signed, or was signed too long ago, the reason is returned
+// as failure.
+func verifyCustomId(customId string, requiresSignature func(customId string) bool) (unwrapped, failure string) {
+	if !signing.Enabled() {
+		return customId, ""
+	}
+
+	if unsigned, issuedAt, signature, ok := signing.Split(customId); ok && requiresSignature(unsigned) {
+		switch signing.Verify(unsigned, issuedAt, signature) {
+		case signing.Invalid:
+			return unsigned, "invalid"
+		case signing.Expired:
+			return unsigned, "expired"
+		}
+
+		return unsigned, ""
+	}
+
+	if requiresSignature(customId) && !signing.AcceptUnsigned() {
+		return customId, "missing"
+	}
+
+	return customId, ""
+}
+
+// loadState loads the state attached to the custom ID, if there is one. If the state could not be loaded, the user is
+// told why, and ok is false.
+func loadState(ctx context.Context, cmd cmdregistry.CommandContext, token string) (*state.State, bool) {
+	if token == "" {
+		return nil, true
+	}
+
+	s, err := state.Load(ctx, token, cmd.GuildId(), cmd.UserId())
+	if err != nil {
+		if errors.Is(err, state.ErrExpired) {
+			cmd.Reply(customisation.Red, i18n.Error, i18n.MessageButtonStateExpired)
+		} else if errors.Is(err, state.ErrNotOwner) {
+			cmd.Reply(customisation.Red, i18n.Error, i18n.MessageButtonStateNotOwner)
+		} else {
+			cmd.HandleError(err)
+		}
+
+		return nil, false
+	}
+
+	return s, true
+}
+
+func rejectCustomId(cmd cmdregistry.CommandContext, componentType, failure string) {
+	prometheus.ComponentSignatureFailures.WithLabelValues(componentType, failure).Inc()
+
+	if failure == "expired" {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageButtonSignatureExpired)
+	} else {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageButtonInvalidSignature)
+	}
+}
+
+func isAppealPanelInteraction(ctx context.Context, data interaction.MessageComponentInteraction) (bool, error) {
+	if data.GuildId.Value == 0 {
+		return false, nil
+	}
+
+	var panelCustomId string
+	switch data.Data.Type() {
+	case component.ComponentButton:
+		panelCustomId = data.Data.AsButton().CustomId
+	case component.ComponentSelectMenu:
+		selectData := data.Data.AsSelectMenu()
+		if selectData.CustomId != "multipanel" || len(selectData.Values) == 0 {
+			return false, nil
+		}
+
+		panelCustomId = selectData.Values[0]
+	default:
+		return false, nil
+	}
+
+	return logic.IsAppealPanelCustomId(ctx, data.GuildId.Value, panelCustomId)
+}
@@ -1,45 +1,65 @@
 package manager
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/handlers"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 )
 
 type ComponentInteractionManager struct {
+	// slash commands, for handlers that need to list them
+	commands cmdregistry.Registry
+
 	buttonRegistry registry.ButtonRegistry
 	selectRegistry registry.SelectRegistry
 	modalRegistry  registry.ModalRegistry
 
-	// button matching engines
+	// button matching engines. Func matchers are tried in registration order, so that matching is deterministic.
 	buttonSimpleMatches  map[string]registry.ButtonHandler
-	buttonFuncMatches    map[registry.ButtonHandler]matcher.FuncMatchEngine
+	buttonPatternMatches []patternMatch[registry.ButtonHandler]
+	buttonFuncMatches    []funcMatch[registry.ButtonHandler]
 	buttonDefaultHandler registry.ButtonHandler
 
 	// select menu matching engines
-	selectSimpleMatches map[string]registry.SelectHandler
-	selectFuncMatches   map[registry.SelectHandler]matcher.FuncMatchEngine
+	selectSimpleMatches  map[string]registry.SelectHandler
+	selectPatternMatches []patternMatch[registry.SelectHandler]
+	selectFuncMatches    []funcMatch[registry.SelectHandler]
 
 	// modal matching engines
-	modalSimpleMatches map[string]registry.ModalHandler
-	modalFuncMatches   map[registry.ModalHandler]matcher.FuncMatchEngine
+	modalSimpleMatches  map[string]registry.ModalHandler
+	modalPatternMatches []patternMatch[registry.ModalHandler]
+	modalFuncMatches    []funcMatch[registry.ModalHandler]
+}
+
+type patternMatch[T any] struct {
+	matcher *matcher.PatternMatcher
+	handler T
 }
 
-func NewButtonManager() *ComponentInteractionManager {
+type funcMatch[T any] struct {
+	f       matcher.FuncMatchEngine
+	handler T
+}
+
+func NewButtonManager(commands cmdregistry.Registry) *ComponentInteractionManager {
 	return &ComponentInteractionManager{
+		commands: commands,
+
 		buttonRegistry: make(registry.ButtonRegistry, 0),
 		selectRegistry: make(registry.SelectRegistry, 0),
 		modalRegistry:  make(registry.ModalRegistry, 0),
 
 		buttonSimpleMatches:  make(map[string]registry.ButtonHandler),
-		buttonFuncMatches:    make(map[registry.ButtonHandler]matcher.FuncMatchEngine),
 		buttonDefaultHandler: nil,
 
 		selectSimpleMatches: make(map[string]registry.SelectHandler),
-		selectFuncMatches:   make(map[registry.SelectHandler]matcher.FuncMatchEngine),
 
 		modalSimpleMatches: make(map[string]registry.ModalHandler),
-		modalFuncMatches:   make(map[registry.ModalHandler]matcher.FuncMatchEngine),
 	}
 }
 
@@ -57,32 +77,56 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.CloseConfirmHandler),
 		new(handlers.CloseRequestAcceptHandler),
 		new(handlers.CloseRequestDenyHandler),
+		new(handlers.ExitSurveyContinueHandler),
+		new(handlers.FormResumeHandler),
+		&handlers.HelpPageHandler{Registry: m.commands},
 		new(handlers.JoinThreadHandler),
+		new(handlers.LegacyRateHandler),
 		new(handlers.OpenSurveyHandler),
 		new(handlers.PanelHandler),
 		new(handlers.RateHandler),
+		new(handlers.RateReasonHandler),
+		new(handlers.SetupWizardButtonHandler),
+		new(handlers.TicketHistoryPageHandler),
+		new(handlers.TicketListClaimHandler),
+		new(handlers.TicketListCloseConfirmHandler),
+		new(handlers.TicketListCloseHandler),
+		new(handlers.TicketListPageHandler),
+		new(handlers.TicketSearchPageHandler),
+		new(handlers.UnblacklistHandler),
 		new(handlers.ViewStaffHandler),
 		new(handlers.ViewSurveyHandler),
 	)
 
 	m.selectRegistry = append(m.selectRegistry,
+		new(handlers.ExitSurveySelectHandler),
+		&handlers.HelpCategoryHandler{Registry: m.commands},
 		new(handlers.LanguageSelectorHandler),
 		new(handlers.MultiPanelHandler),
+		new(handlers.SetupWizardSelectHandler),
+		new(handlers.TicketHistoryPanelHandler),
 	)
 
 	m.modalRegistry = append(m.modalRegistry,
 		new(handlers.FormHandler),
 		new(handlers.CloseWithReasonSubmitHandler),
 		new(handlers.ExitSurveySubmitHandler),
+		new(handlers.RateReasonSubmitHandler),
 	)
 
 	for _, handler := range m.buttonRegistry {
 		switch engine := handler.Matcher().(type) {
 		case *matcher.SimpleMatcher:
-			m.buttonSimpleMatches[engine.CustomId] = handler
+			registerSimple(m.buttonSimpleMatches, engine.CustomId, handler)
+		case *matcher.PatternMatcher:
+			m.buttonPatternMatches = registerPattern(m.buttonPatternMatches, engine, handler)
 		case *matcher.FuncMatcher:
-			m.buttonFuncMatches[handler] = engine.Func
+			m.buttonFuncMatches = append(m.buttonFuncMatches, funcMatch[registry.ButtonHandler]{engine.Func, handler})
 		case *matcher.DefaultMatcher:
+			if m.buttonDefaultHandler != nil {
+				panic("only one button handler may use the default matcher")
+			}
+
 			m.buttonDefaultHandler = handler
 		}
 	}
@@ -90,9 +134,11 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 	for _, handler := range m.selectRegistry {
 		switch engine := handler.Matcher().(type) {
 		case *matcher.SimpleMatcher:
-			m.selectSimpleMatches[engine.CustomId] = handler
+			registerSimple(m.selectSimpleMatches, engine.CustomId, handler)
+		case *matcher.PatternMatcher:
+			m.selectPatternMatches = registerPattern(m.selectPatternMatches, engine, handler)
 		case *matcher.FuncMatcher:
-			m.selectFuncMatches[handler] = engine.Func
+			m.selectFuncMatches = append(m.selectFuncMatches, funcMatch[registry.SelectHandler]{engine.Func, handler})
 		case *matcher.DefaultMatcher:
 			panic("default matcher not allowed for select menu")
 		}
@@ -101,60 +147,102 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 	for _, handler := range m.modalRegistry {
 		switch engine := handler.Matcher().(type) {
 		case *matcher.SimpleMatcher:
-			m.modalSimpleMatches[engine.CustomId] = handler
+			registerSimple(m.modalSimpleMatches, engine.CustomId, handler)
+		case *matcher.PatternMatcher:
+			m.modalPatternMatches = registerPattern(m.modalPatternMatches, engine, handler)
 		case *matcher.FuncMatcher:
-			m.modalFuncMatches[handler] = engine.Func
+			m.modalFuncMatches = append(m.modalFuncMatches, funcMatch[registry.ModalHandler]{engine.Func, handler})
 		case *matcher.DefaultMatcher:
-			panic("default matcher not allowed for select menu")
+			panic("default matcher not allowed for modal")
 		}
 	}
 }
 
-func (m *ComponentInteractionManager) MatchButton(customId string) registry.ButtonHandler {
-	// Try simple match first
-	handler, ok := m.buttonSimpleMatches[customId]
-	if ok {
-		return handler
-	}
-
-	for handler, f := range m.buttonFuncMatches {
-		if f(customId) {
-			return handler
-		}
+// MatchButton returns the handler for a custom ID, along with any parameters parsed from it. Exact matches are
+// preferred, followed by patterns (most specific first), then func matchers in registration order, then the default.
+func (m *ComponentInteractionManager) MatchButton(customId string) (registry.ButtonHandler, matcher.Params) {
+	if handler, params, ok := match(customId, m.buttonSimpleMatches, m.buttonPatternMatches, m.buttonFuncMatches); ok {
+		return handler, params
 	}
 
 	// Ok to return nil
-	return m.buttonDefaultHandler
+	return m.buttonDefaultHandler, nil
+}
+
+func (m *ComponentInteractionManager) MatchSelect(customId string) (registry.SelectHandler, matcher.Params) {
+	handler, params, _ := match(customId, m.selectSimpleMatches, m.selectPatternMatches, m.selectFuncMatches)
+	return handler, params
+}
+
+func (m *ComponentInteractionManager) MatchModal(customId string) (registry.ModalHandler, matcher.Params) {
+	handler, params, _ := match(customId, m.modalSimpleMatches, m.modalPatternMatches, m.modalFuncMatches)
+	return handler, params
+}
+
+func (m *ComponentInteractionManager) buttonRequiresSignature(customId string) bool {
+	customId, _, _ = state.Detach(customId)
+	handler, _ := m.MatchButton(customId)
+	return handler != nil && handler.Properties().RequireSignature
+}
+
+func (m *ComponentInteractionManager) selectRequiresSignature(customId string) bool {
+	customId, _, _ = state.Detach(customId)
+	handler, _ := m.MatchSelect(customId)
+	return handler != nil && handler.Properties().RequireSignature
+}
+
+func (m *ComponentInteractionManager) modalRequiresSignature(customId string) bool {
+	customId, _, _ = state.Detach(customId)
+	handler, _ := m.MatchModal(customId)
+	return handler != nil && handler.Properties().RequireSignature
 }
 
-func (m *ComponentInteractionManager) MatchSelect(customId string) registry.SelectHandler {
-	// Try simple match first
-	handler, ok := m.selectSimpleMatches[customId]
-	if ok {
-		return handler
+func match[T any](customId string, simple map[string]T, patterns []patternMatch[T], funcs []funcMatch[T]) (T, matcher.Params, bool) {
+	if handler, ok := simple[customId]; ok {
+		return handler, nil, true
 	}
 
-	for handler, f := range m.selectFuncMatches {
-		if f(customId) {
-			return handler
+	for _, pattern := range patterns {
+		if params, ok := pattern.matcher.Match(customId); ok {
+			return pattern.handler, params, true
 		}
 	}
 
-	return nil
+	for _, f := range funcs {
+		if f.f(customId) {
+			return f.handler, nil, true
+		}
+	}
+
+	var zero T
+	return zero, nil, false
 }
 
-func (m *ComponentInteractionManager) MatchModal(customId string) registry.ModalHandler {
-	// Try simple match first
-	handler, ok := m.modalSimpleMatches[customId]
-	if ok {
-		return handler
+func registerSimple[T any](matches map[string]T, customId string, handler T) {
+	if _, ok := matches[customId]; ok {
+		panic(fmt.Sprintf("custom ID %s is registered by more than one handler", customId))
 	}
 
-	for handler, f := range m.modalFuncMatches {
-		if f(customId) {
-			return handler
+	matches[customId] = handler
+}
+
+// registerPattern compiles the pattern and inserts it into the list, keeping the list sorted by precedence. Patterns
+// that fail to compile, or that match exactly the same custom IDs as an existing pattern, cause a panic at startup.
+func registerPattern[T any](matches []patternMatch[T], engine *matcher.PatternMatcher, handler T) []patternMatch[T] {
+	if err := engine.Compile(); err != nil {
+		panic(err)
+	}
+
+	for _, existing := range matches {
+		if existing.matcher.Shape() == engine.Shape() {
+			panic(fmt.Sprintf("pattern %s conflicts with pattern %s", engine.Pattern, existing.matcher.Pattern))
 		}
 	}
 
-	return nil
+	matches = append(matches, patternMatch[T]{engine, handler})
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].matcher.Precedes(matches[j].matcher)
+	})
+
+	return matches
 }
@@ -6,6 +6,7 @@ import (
 
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
 	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	"github.com/rxdn/gdl/objects/interaction"
 )
@@ -23,18 +24,37 @@ func HandleModalInteraction(ctx context.Context, manager *ComponentInteractionMa
 	lookupCtx, cancelLookupCtx := context.WithTimeout(ctx, time.Second*2)
 	defer cancelLookupCtx()
 
-	handler := manager.MatchModal(data.Data.CustomId)
+	customId, failure := verifyCustomId(data.Data.CustomId, manager.modalRequiresSignature)
+	if failure != "" {
+		rejectCustomId(cmdcontext.NewModalContext(ctx, worker, data, responseCh), "modal", failure)
+		return false
+	}
+
+	customId, stateToken, _ := state.Detach(customId)
+
+	handler, params := manager.MatchModal(customId)
 	if handler == nil {
 		return false
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, handler.Properties().Timeout)
 
+	data.Data.CustomId = customId
 	cc := cmdcontext.NewModalContext(ctx, worker, data, responseCh)
+	cc.Params = params
+
 	shouldExecute, canEdit := doPropertiesChecks(lookupCtx, data.GuildId.Value, cc, handler.Properties())
 	if shouldExecute {
 		go func() {
 			defer cancel()
+
+			componentState, ok := loadState(cc, cc, stateToken)
+			if !ok {
+				return
+			}
+
+			cc.State = componentState
+
 			handler.Execute(cc)
 		}()
 	} else {
@@ -10,6 +10,7 @@ const (
 	TypeSimple Type = iota
 	TypeFunc
 	TypeDefault
+	TypePattern
 )
 
 
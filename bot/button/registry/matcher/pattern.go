@@ -0,0 +1,217 @@
+package matcher
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type ParamType string
+
+const (
+	ParamString ParamType = "str"
+	ParamInt    ParamType = "int"
+	ParamUint64 ParamType = "u64"
+)
+
+// PatternMatcher matches custom IDs against a pattern made up of literal text and typed parameters, written as
+// {name:type}, e.g. rate_{guild:u64}_{ticket:int}_{score:int}. Parameters must be separated by literal text, and a
+// str parameter stops at the first occurrence of the literal text that follows it.
+//
+// Where more than one pattern matches a custom ID, the pattern with the most literal text wins, followed by the pattern
+// with the most numeric parameters, with any remaining tie broken by the pattern text.
+type PatternMatcher struct {
+	Pattern string
+
+	regex         *regexp.Regexp
+	params        []patternParam
+	literalLength int
+	shape         string
+}
+
+type patternParam struct {
+	name      string
+	paramType ParamType
+}
+
+func NewPatternMatcher(pattern string) *PatternMatcher {
+	return &PatternMatcher{
+		Pattern: pattern,
+	}
+}
+
+func (m *PatternMatcher) Type() Type {
+	return TypePattern
+}
+
+// Compile parses the pattern. The component manager calls this at registration, so that a malformed pattern stops
+// the worker from starting, rather than surfacing when a user clicks a button.
+func (m *PatternMatcher) Compile() error {
+	var regex, shape strings.Builder
+	regex.WriteString("^")
+
+	var params []patternParam
+	var literalLength int
+	seen := make(map[string]bool)
+
+	remaining := m.Pattern
+	for len(remaining) > 0 {
+		open := strings.IndexByte(remaining, '{')
+		if open == -1 {
+			open = len(remaining)
+		}
+
+		if strings.IndexByte(remaining[:open], '}') != -1 {
+			return fmt.Errorf("pattern %s: unexpected }", m.Pattern)
+		}
+
+		if literal := remaining[:open]; literal != "" {
+			regex.WriteString(regexp.QuoteMeta(literal))
+			shape.WriteString(literal)
+			literalLength += len(literal)
+			remaining = remaining[open:]
+			continue
+		}
+
+		end := strings.IndexByte(remaining, '}')
+		if end == -1 {
+			return fmt.Errorf("pattern %s: unclosed {", m.Pattern)
+		}
+
+		name, paramType, found := strings.Cut(remaining[1:end], ":")
+		if !found || name == "" {
+			return fmt.Errorf("pattern %s: parameters must be written as {name:type}", m.Pattern)
+		}
+
+		if seen[name] {
+			return fmt.Errorf("pattern %s: duplicate parameter %s", m.Pattern, name)
+		}
+
+		if strings.HasSuffix(shape.String(), "}") {
+			return fmt.Errorf("pattern %s: parameters must be separated by literal text", m.Pattern)
+		}
+
+		remaining = remaining[end+1:]
+
+		var group string
+		switch ParamType(paramType) {
+		case ParamInt:
+			group = `-?\d+`
+		case ParamUint64:
+			group = `\d+`
+		case ParamString:
+			if len(remaining) == 0 {
+				group = `.+`
+			} else {
+				group = fmt.Sprintf(`[^%s]+`, regexp.QuoteMeta(remaining[:1]))
+			}
+		default:
+			return fmt.Errorf("pattern %s: unknown parameter type %s", m.Pattern, paramType)
+		}
+
+		regex.WriteString("(" + group + ")")
+		shape.WriteString("{" + paramType + "}")
+
+		seen[name] = true
+		params = append(params, patternParam{name, ParamType(paramType)})
+	}
+
+	regex.WriteString("$")
+
+	compiled, err := regexp.Compile(regex.String())
+	if err != nil {
+		return fmt.Errorf("pattern %s: %w", m.Pattern, err)
+	}
+
+	m.regex = compiled
+	m.params = params
+	m.literalLength = literalLength
+	m.shape = shape.String()
+	return nil
+}
+
+// Match returns the parameters parsed from the custom ID, if it matches the pattern
+func (m *PatternMatcher) Match(customId string) (Params, bool) {
+	if m.regex == nil {
+		return nil, false
+	}
+
+	groups := m.regex.FindStringSubmatch(customId)
+	if groups == nil {
+		return nil, false
+	}
+
+	params := make(Params, len(m.params))
+	for i, param := range m.params {
+		raw := groups[i+1]
+
+		switch param.paramType {
+		case ParamInt:
+			value, err := strconv.Atoi(raw)
+			if err != nil {
+				return nil, false
+			}
+
+			params[param.name] = value
+		case ParamUint64:
+			value, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return nil, false
+			}
+
+			params[param.name] = value
+		default:
+			params[param.name] = raw
+		}
+	}
+
+	return params, true
+}
+
+// Shape returns the pattern with parameter names removed. Two patterns with the same shape match exactly the same
+// custom IDs, so cannot both be registered.
+func (m *PatternMatcher) Shape() string {
+	return m.shape
+}
+
+// Precedes returns whether the matcher should be tried before other
+func (m *PatternMatcher) Precedes(other *PatternMatcher) bool {
+	if m.literalLength != other.literalLength {
+		return m.literalLength > other.literalLength
+	}
+
+	if numeric, otherNumeric := m.numericParams(), other.numericParams(); numeric != otherNumeric {
+		return numeric > otherNumeric
+	}
+
+	return m.Pattern < other.Pattern
+}
+
+func (m *PatternMatcher) numericParams() (count int) {
+	for _, param := range m.params {
+		if param.paramType != ParamString {
+			count++
+		}
+	}
+
+	return
+}
+
+// Params holds the parameters parsed from a custom ID by a PatternMatcher
+type Params map[string]interface{}
+
+func (p Params) String(name string) string {
+	value, _ := p[name].(string)
+	return value
+}
+
+func (p Params) Int(name string) int {
+	value, _ := p[name].(int)
+	return value
+}
+
+func (p Params) Uint64(name string) uint64 {
+	value, _ := p[name].(uint64)
+	return value
+}
@@ -0,0 +1,70 @@
+package matcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatternMatch(t *testing.T) {
+	m := NewPatternMatcher("rate_{guild:u64}_{ticket:int}_{score:int}")
+	require.NoError(t, m.Compile())
+
+	params, ok := m.Match("rate_508392876359680000_15_4")
+	require.True(t, ok)
+	require.Equal(t, uint64(508392876359680000), params.Uint64("guild"))
+	require.Equal(t, 15, params.Int("ticket"))
+	require.Equal(t, 4, params.Int("score"))
+}
+
+func TestPatternNoMatch(t *testing.T) {
+	m := NewPatternMatcher("viewstaff_{page:int}")
+	require.NoError(t, m.Compile())
+
+	for _, customId := range []string{"viewstaff_", "viewstaff_a", "viewstaff_1_2", "xviewstaff_1"} {
+		_, ok := m.Match(customId)
+		require.False(t, ok, customId)
+	}
+}
+
+func TestPatternString(t *testing.T) {
+	m := NewPatternMatcher("form_{panel:str}_{field:int}")
+	require.NoError(t, m.Compile())
+
+	params, ok := m.Match("form_abc-def_3")
+	require.True(t, ok)
+	require.Equal(t, "abc-def", params.String("panel"))
+	require.Equal(t, 3, params.Int("field"))
+}
+
+func TestPatternCompileErrors(t *testing.T) {
+	for _, pattern := range []string{
+		"a_{id:int",
+		"a_}",
+		"a_{id}",
+		"a_{id:float}",
+		"a_{id:int}_{id:int}",
+		"a_{x:int}{y:int}",
+	} {
+		require.Error(t, NewPatternMatcher(pattern).Compile(), pattern)
+	}
+}
+
+func TestPatternPrecedence(t *testing.T) {
+	specific := NewPatternMatcher("survey_view_{id:int}")
+	general := NewPatternMatcher("survey_{action:str}_{id:int}")
+	require.NoError(t, specific.Compile())
+	require.NoError(t, general.Compile())
+
+	require.True(t, specific.Precedes(general))
+	require.False(t, general.Precedes(specific))
+}
+
+func TestPatternShape(t *testing.T) {
+	a := NewPatternMatcher("page_{a:int}")
+	b := NewPatternMatcher("page_{b:int}")
+	require.NoError(t, a.Compile())
+	require.NoError(t, b.Compile())
+
+	require.Equal(t, a.Shape(), b.Shape())
+}
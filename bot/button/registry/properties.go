@@ -9,6 +9,9 @@ type Properties struct {
 	Flags           int
 	PermissionLevel permission.PermissionLevel
 	Timeout         time.Duration
+	// RequireSignature rejects custom IDs that were not signed by us, for handlers that trust the data in the ID. It
+	// has no effect unless a signing key is configured.
+	RequireSignature bool
 }
 
 func (p *Properties) HasFlag(flag Flag) bool {
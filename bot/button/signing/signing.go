@@ -0,0 +1,99 @@
+package signing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+)
+
+const (
+	separator = "~"
+
+	// macLength is the number of base64 characters of the MAC kept in the custom ID (72 bits), which keeps signed IDs
+	// well within Discord's 100 character limit
+	macLength = 12
+
+	// The issued-at time is stored as base 36 unix seconds, which is 6 characters until the year 2038
+	issuedAtBase = 36
+)
+
+// Result is the outcome of verifying a signed custom ID
+type Result uint8
+
+const (
+	Valid Result = iota
+	Invalid
+	Expired
+)
+
+// Enabled returns whether a signing key has been configured
+func Enabled() bool {
+	return len(config.Conf.ComponentSigning.Keys) > 0
+}
+
+// AcceptUnsigned returns whether custom IDs that require a signature may still be used without one. This allows
+// components sent before signing was enabled to keep working for a grace period during rollout.
+func AcceptUnsigned() bool {
+	return time.Now().Before(config.Conf.ComponentSigning.AcceptUnsignedUntil)
+}
+
+// Sign appends the current time and a MAC to the custom ID, so that the component manager can verify that the ID was
+// generated by us, and not too long ago. If no signing key is configured, the custom ID is returned unchanged.
+func Sign(customId string) string {
+	if !Enabled() {
+		return customId
+	}
+
+	payload := customId + separator + strconv.FormatInt(time.Now().Unix(), issuedAtBase)
+	return payload + separator + mac(config.Conf.ComponentSigning.Keys[0], payload)
+}
+
+// Split separates a signed custom ID into the original custom ID, the time it was signed and its MAC. ok is false if
+// the custom ID does not look like it has been signed.
+func Split(customId string) (unsigned string, issuedAt time.Time, signature string, ok bool) {
+	macIdx := strings.LastIndex(customId, separator)
+	if macIdx == -1 || len(customId)-macIdx-len(separator) != macLength {
+		return customId, time.Time{}, "", false
+	}
+
+	issuedAtIdx := strings.LastIndex(customId[:macIdx], separator)
+	if issuedAtIdx == -1 {
+		return customId, time.Time{}, "", false
+	}
+
+	seconds, err := strconv.ParseInt(customId[issuedAtIdx+len(separator):macIdx], issuedAtBase, 64)
+	if err != nil {
+		return customId, time.Time{}, "", false
+	}
+
+	return customId[:issuedAtIdx], time.Unix(seconds, 0), customId[macIdx+len(separator):], true
+}
+
+// Verify checks the signature for the custom ID and issued-at time under any of the configured keys, and that it was
+// issued no longer than the configured max age ago
+func Verify(customId string, issuedAt time.Time, signature string) Result {
+	payload := customId + separator + strconv.FormatInt(issuedAt.Unix(), issuedAtBase)
+
+	for _, key := range config.Conf.ComponentSigning.Keys {
+		if hmac.Equal([]byte(mac(key, payload)), []byte(signature)) {
+			if maxAge := config.Conf.ComponentSigning.MaxAge; maxAge > 0 && time.Since(issuedAt) > maxAge {
+				return Expired
+			}
+
+			return Valid
+		}
+	}
+
+	return Invalid
+}
+
+func mac(key, payload string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))[:macLength]
+}
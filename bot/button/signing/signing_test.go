@@ -0,0 +1,82 @@
+package signing
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/stretchr/testify/require"
+)
+
+func withSigningConfig(t *testing.T, keys []string, maxAge time.Duration, acceptUnsignedUntil time.Time) {
+	previous := config.Conf.ComponentSigning
+	t.Cleanup(func() {
+		config.Conf.ComponentSigning = previous
+	})
+
+	config.Conf.ComponentSigning.Keys = keys
+	config.Conf.ComponentSigning.MaxAge = maxAge
+	config.Conf.ComponentSigning.AcceptUnsignedUntil = acceptUnsignedUntil
+}
+
+func TestSignRoundTrip(t *testing.T) {
+	withSigningConfig(t, []string{"key"}, time.Hour, time.Time{})
+
+	unsigned, issuedAt, signature, ok := Split(Sign("rate_123_5"))
+	require.True(t, ok)
+	require.Equal(t, "rate_123_5", unsigned)
+	require.WithinDuration(t, time.Now(), issuedAt, time.Minute)
+	require.Equal(t, Valid, Verify(unsigned, issuedAt, signature))
+}
+
+func TestVerifyRotatedKey(t *testing.T) {
+	withSigningConfig(t, []string{"old"}, time.Hour, time.Time{})
+	signed := Sign("rate_123_5")
+
+	config.Conf.ComponentSigning.Keys = []string{"new", "old"}
+	unsigned, issuedAt, signature, ok := Split(signed)
+	require.True(t, ok)
+	require.Equal(t, Valid, Verify(unsigned, issuedAt, signature))
+}
+
+func TestVerifyTampered(t *testing.T) {
+	withSigningConfig(t, []string{"key"}, time.Hour, time.Time{})
+
+	unsigned, issuedAt, signature, ok := Split(Sign("rate_123_5"))
+	require.True(t, ok)
+	require.Equal(t, Invalid, Verify("rate_456_5", issuedAt, signature))
+	require.Equal(t, Invalid, Verify(unsigned, issuedAt.Add(time.Hour), signature))
+}
+
+func TestVerifyExpired(t *testing.T) {
+	withSigningConfig(t, []string{"key"}, time.Hour, time.Time{})
+
+	issuedAt := time.Now().Add(-2 * time.Hour)
+	payload := "rate_123_5" + separator + strconv.FormatInt(issuedAt.Unix(), issuedAtBase)
+	unsigned, issuedAt, signature, ok := Split(payload + separator + mac("key", payload))
+	require.True(t, ok)
+	require.Equal(t, Expired, Verify(unsigned, issuedAt, signature))
+
+	config.Conf.ComponentSigning.MaxAge = 0
+	require.Equal(t, Valid, Verify(unsigned, issuedAt, signature))
+}
+
+func TestSplitUnsigned(t *testing.T) {
+	_, _, _, ok := Split("rate_123_5")
+	require.False(t, ok)
+
+	_, _, _, ok = Split("rate_123_5~abcdefghijkl")
+	require.False(t, ok)
+}
+
+func TestAcceptUnsigned(t *testing.T) {
+	withSigningConfig(t, []string{"key"}, time.Hour, time.Now().Add(time.Hour))
+	require.True(t, AcceptUnsigned())
+
+	config.Conf.ComponentSigning.AcceptUnsignedUntil = time.Now().Add(-time.Hour)
+	require.False(t, AcceptUnsigned())
+
+	config.Conf.ComponentSigning.AcceptUnsignedUntil = time.Time{}
+	require.False(t, AcceptUnsigned())
+}
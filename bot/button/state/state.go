@@ -0,0 +1,179 @@
+package state
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+)
+
+const (
+	// marker divides the custom ID from the state token. It is long enough that it is not found at the end of panel or
+	// other user-defined custom IDs, which would otherwise be mistaken for carrying a token and fail to match.
+	marker      = "#state:"
+	tokenLength = 12
+
+	DefaultExpiry = time.Minute * 15
+)
+
+var (
+	ErrExpired  = redis.ErrComponentStateNotFound
+	ErrNotOwner = errors.New("component state belongs to another user or guild")
+)
+
+// State is data stored server-side for a component, so that it does not need to be squeezed into the custom ID. The
+// component manager loads the state before the handler is executed.
+type State struct {
+	Token   string
+	GuildId uint64
+	UserId  uint64
+	data    []byte
+}
+
+// envelope is what is stored in Redis. The guild and user that created the state are kept alongside the value, so
+// that the state can't be used from another guild, or by another user who has been shown the component.
+type envelope struct {
+	GuildId uint64          `json:"guild_id"`
+	UserId  uint64          `json:"user_id"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// Save stores the value, JSON encoded, and returns the token to pass to Attach. Only the user may use the state, and
+// only in the guild, which is 0 for DMs.
+func Save(ctx context.Context, guildId, userId uint64, value interface{}, expiry time.Duration) (string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(envelope{GuildId: guildId, UserId: userId, Data: raw})
+	if err != nil {
+		return "", err
+	}
+
+	// Retry in the unlikely event of a collision
+	for i := 0; i < 3; i++ {
+		token, err := generateToken()
+		if err != nil {
+			return "", err
+		}
+
+		ok, err := redis.StoreComponentState(ctx, token, data, expiry)
+		if err != nil {
+			return "", err
+		}
+
+		if ok {
+			return token, nil
+		}
+	}
+
+	return "", errors.New("failed to generate a unique component state token")
+}
+
+// Load fetches the state stored under the token, returning ErrExpired if it has expired, or ErrNotOwner if it was not
+// created by the user in the guild
+func Load(ctx context.Context, token string, guildId, userId uint64) (*State, error) {
+	data, err := redis.GetComponentState(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored envelope
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+
+	if stored.GuildId != guildId || stored.UserId != userId {
+		return nil, ErrNotOwner
+	}
+
+	return &State{
+		Token:   token,
+		GuildId: stored.GuildId,
+		UserId:  stored.UserId,
+		data:    stored.Data,
+	}, nil
+}
+
+// Decode unmarshals the state into v
+func (s *State) Decode(v interface{}) error {
+	return json.Unmarshal(s.data, v)
+}
+
+// Update replaces the state, keeping the same token and expiry, so that components referencing it can be reused, e.g.
+// when moving between pages
+func (s *State) Update(ctx context.Context, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(envelope{GuildId: s.GuildId, UserId: s.UserId, Data: raw})
+	if err != nil {
+		return err
+	}
+
+	if err := redis.UpdateComponentState(ctx, s.Token, data); err != nil {
+		return err
+	}
+
+	s.data = raw
+	return nil
+}
+
+// Delete removes the state, e.g. once a multi-step flow has been completed. ok is false if the state had already been
+// deleted, so that a flow completed by two interactions at once is only completed by one of them.
+func (s *State) Delete(ctx context.Context) (ok bool, err error) {
+	return redis.DeleteComponentState(ctx, s.Token)
+}
+
+// Attach adds the state token to a custom ID
+func Attach(customId, token string) string {
+	return customId + marker + token
+}
+
+// Detach separates the state token from a custom ID. ok is false if the custom ID does not carry a token.
+func Detach(customId string) (stripped, token string, ok bool) {
+	idx := strings.LastIndex(customId, marker)
+	if idx == -1 {
+		return customId, "", false
+	}
+
+	token = customId[idx+len(marker):]
+	if !isToken(token) {
+		return customId, "", false
+	}
+
+	return customId[:idx], token, true
+}
+
+// isToken returns whether s could have been generated by generateToken
+func isToken(s string) bool {
+	if len(s) != tokenLength {
+		return false
+	}
+
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func generateToken() (string, error) {
+	// 9 bytes encodes to 12 base64 characters without padding
+	bytes := make([]byte, tokenLength/4*3)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
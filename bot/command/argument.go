@@ -1,22 +1,34 @@
 package command
 
 import (
+	"fmt"
+	"unicode/utf8"
+
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/interaction"
 )
 
 type Argument struct {
 	Name                string
-	Description         string
+	Description         i18n.MessageId
 	Type                interaction.ApplicationCommandOptionType
 	Required            bool
 	InvalidMessage      i18n.MessageId
 	AutoCompleteHandler AutoCompleteHandler
+
+	// Constraints, which are sent to Discord at registration and enforced again before the command is executed
+	MinValue     *float64
+	MaxValue     *float64
+	MinLength    *int
+	MaxLength    *int
+	Choices      []interaction.ApplicationCommandOptionChoice
+	ChannelTypes []channel.ChannelType
 }
 
 type AutoCompleteHandler func(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice
 
-func NewOptionalArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
+func NewOptionalArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -27,7 +39,7 @@ func NewOptionalArgument(name, description string, argumentType interaction.Appl
 	}
 }
 
-func NewRequiredArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
+func NewRequiredArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -38,7 +50,7 @@ func NewRequiredArgument(name, description string, argumentType interaction.Appl
 	}
 }
 
-func NewOptionalAutocompleteableArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
+func NewOptionalAutocompleteableArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -49,7 +61,7 @@ func NewOptionalAutocompleteableArgument(name, description string, argumentType
 	}
 }
 
-func NewRequiredAutocompleteableArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
+func NewRequiredAutocompleteableArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -60,6 +72,73 @@ func NewRequiredAutocompleteableArgument(name, description string, argumentType
 	}
 }
 
+func (a Argument) WithMinValue(min float64) Argument {
+	a.MinValue = &min
+	return a
+}
+
+func (a Argument) WithMaxValue(max float64) Argument {
+	a.MaxValue = &max
+	return a
+}
+
+func (a Argument) WithMinLength(min int) Argument {
+	a.MinLength = &min
+	return a
+}
+
+func (a Argument) WithMaxLength(max int) Argument {
+	a.MaxLength = &max
+	return a
+}
+
+func (a Argument) WithChoices(choices ...interaction.ApplicationCommandOptionChoice) Argument {
+	a.Choices = choices
+	return a
+}
+
+func (a Argument) WithChannelTypes(channelTypes ...channel.ChannelType) Argument {
+	a.ChannelTypes = channelTypes
+	return a
+}
+
+// Validate checks the raw option value received from Discord against the argument's constraints. If a constraint is
+// violated, the message to show the user and its format arguments are returned. Channel types can't be checked from
+// the raw value, so are only enforced by Discord.
+func (a Argument) Validate(value interface{}) (ok bool, message i18n.MessageId, format []interface{}) {
+	switch v := value.(type) {
+	case float64:
+		if a.MinValue != nil && v < *a.MinValue {
+			return false, i18n.MessageArgumentTooSmall, []interface{}{a.Name, *a.MinValue}
+		}
+
+		if a.MaxValue != nil && v > *a.MaxValue {
+			return false, i18n.MessageArgumentTooLarge, []interface{}{a.Name, *a.MaxValue}
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if a.MinLength != nil && length < *a.MinLength {
+			return false, i18n.MessageArgumentTooShort, []interface{}{a.Name, *a.MinLength}
+		}
+
+		if a.MaxLength != nil && length > *a.MaxLength {
+			return false, i18n.MessageArgumentTooLong, []interface{}{a.Name, *a.MaxLength}
+		}
+	}
+
+	if len(a.Choices) > 0 {
+		for _, choice := range a.Choices {
+			if fmt.Sprint(choice.Value) == fmt.Sprint(value) {
+				return true, "", nil
+			}
+		}
+
+		return false, i18n.MessageArgumentInvalidChoice, []interface{}{a.Name}
+	}
+
+	return true, "", nil
+}
+
 func Arguments(argument ...Argument) []Argument {
 	return argument
 }
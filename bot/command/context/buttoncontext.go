@@ -8,6 +8,8 @@ import (
 	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
@@ -30,6 +32,8 @@ type ButtonContext struct {
 	worker          *worker.Context
 	Interaction     interaction.MessageComponentInteraction
 	InteractionData interaction.ButtonInteractionData
+	Params          matcher.Params
+	State           *state.State
 	hasReplied      *atomic.Bool
 	responseChannel chan button.Response
 }
@@ -9,6 +9,8 @@ import (
 	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
@@ -32,6 +34,8 @@ type ModalContext struct {
 	*StateCache
 	worker          *worker.Context
 	Interaction     interaction.ModalSubmitInteraction
+	Params          matcher.Params
+	State           *state.State
 	hasReplied      *atomic.Bool
 	responseChannel chan button.Response
 }
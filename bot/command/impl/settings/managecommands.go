@@ -0,0 +1,105 @@
+package settings
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type ManageCommandsCommand struct {
+	Registry registry.Registry
+}
+
+func (c ManageCommandsCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "managecommands",
+		Description:     i18n.HelpManageCommands,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Children: []registry.Command{
+			ManageCommandsDisableCommand{Registry: c.Registry},
+			ManageCommandsEnableCommand{Registry: c.Registry},
+			ManageCommandsRestrictCommand{Registry: c.Registry},
+			ManageCommandsUnrestrictCommand{Registry: c.Registry},
+			ManageCommandsListCommand{},
+		},
+		Category:         command.Settings,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+	}
+}
+
+func (c ManageCommandsCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ManageCommandsCommand) Execute(_ registry.CommandContext) {
+	// Cannot call parent command
+}
+
+// commandPaths lists every slash command and subcommand that can be restricted, e.g. "setup" and "setup limit"
+func commandPaths(commands registry.Registry) []string {
+	var paths []string
+
+	var walk func(prefix string, cmd registry.Command)
+	walk = func(prefix string, cmd registry.Command) {
+		properties := cmd.Properties()
+		if properties.MessageOnly || properties.Type != interaction.ApplicationCommandTypeChatInput {
+			return
+		}
+
+		path := strings.TrimSpace(prefix + " " + properties.Name)
+		paths = append(paths, path)
+
+		for _, child := range properties.Children {
+			walk(path, child)
+		}
+	}
+
+	for _, cmd := range commands {
+		walk("", cmd)
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+// normaliseCommandPath accepts a command as typed by the user, e.g. "/Setup  limit", and returns its key if it exists
+func normaliseCommandPath(commands registry.Registry, input string) (string, bool) {
+	path := strings.Join(strings.Fields(strings.ToLower(strings.TrimPrefix(strings.TrimSpace(input), "/"))), " ")
+
+	for _, existing := range commandPaths(commands) {
+		if existing == path {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+func commandPathAutoComplete(commands registry.Registry, value string) []interaction.ApplicationCommandOptionChoice {
+	value = strings.ToLower(strings.TrimPrefix(value, "/"))
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, path := range commandPaths(commands) {
+		if !strings.Contains(path, value) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  "/" + path,
+			Value: path,
+		})
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
@@ -0,0 +1,62 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type ManageCommandsDisableCommand struct {
+	Registry registry.Registry
+}
+
+func (c ManageCommandsDisableCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "disable",
+		Description:     i18n.HelpManageCommandsDisable,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		InteractionOnly: true,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("command", i18n.ArgumentManageCommandsCommand, interaction.OptionTypeString, i18n.MessageManageCommandsInvalidCommand, c.AutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 3,
+	}
+}
+
+func (c ManageCommandsDisableCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c ManageCommandsDisableCommand) Execute(ctx registry.CommandContext, commandName string) {
+	path, ok := normaliseCommandPath(c.Registry, commandName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageManageCommandsInvalidCommand)
+		return
+	}
+
+	if logic.IsUnrestrictableCommand(path) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageManageCommandsUnrestrictable, path)
+		return
+	}
+
+	if err := dbclient.Local.CommandRestrictions.SetDisabled(ctx, ctx.GuildId(), path, true); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleManageCommands, i18n.MessageManageCommandsDisabled, path)
+}
+
+func (c ManageCommandsDisableCommand) AutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	return commandPathAutoComplete(c.Registry, value)
+}
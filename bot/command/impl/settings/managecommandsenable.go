@@ -0,0 +1,56 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type ManageCommandsEnableCommand struct {
+	Registry registry.Registry
+}
+
+func (c ManageCommandsEnableCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "enable",
+		Description:     i18n.HelpManageCommandsEnable,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		InteractionOnly: true,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("command", i18n.ArgumentManageCommandsCommand, interaction.OptionTypeString, i18n.MessageManageCommandsInvalidCommand, c.AutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 3,
+	}
+}
+
+func (c ManageCommandsEnableCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c ManageCommandsEnableCommand) Execute(ctx registry.CommandContext, commandName string) {
+	path, ok := normaliseCommandPath(c.Registry, commandName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageManageCommandsInvalidCommand)
+		return
+	}
+
+	if err := dbclient.Local.CommandRestrictions.SetDisabled(ctx, ctx.GuildId(), path, false); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleManageCommands, i18n.MessageManageCommandsEnabled, path)
+}
+
+func (c ManageCommandsEnableCommand) AutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	return commandPathAutoComplete(c.Registry, value)
+}
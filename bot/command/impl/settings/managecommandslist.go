@@ -0,0 +1,68 @@
+package settings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type ManageCommandsListCommand struct {
+}
+
+func (ManageCommandsListCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "list",
+		Description:      i18n.HelpManageCommandsList,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Admin,
+		Category:         command.Settings,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 3,
+	}
+}
+
+func (c ManageCommandsListCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ManageCommandsListCommand) Execute(ctx registry.CommandContext) {
+	restrictions, err := dbclient.Local.CommandRestrictions.GetAll(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(restrictions) == 0 {
+		ctx.Reply(customisation.Green, i18n.TitleManageCommands, i18n.MessageManageCommandsListEmpty)
+		return
+	}
+
+	lines := make([]string, 0, len(restrictions))
+	for path, restriction := range restrictions {
+		if restriction.Disabled {
+			lines = append(lines, fmt.Sprintf("• `/%s`: %s", path, ctx.GetMessage(i18n.MessageManageCommandsListDisabled)))
+			continue
+		}
+
+		roles := make([]string, len(restriction.AllowedRoles))
+		for i, roleId := range restriction.AllowedRoles {
+			roles[i] = fmt.Sprintf("<@&%d>", roleId)
+		}
+
+		lines = append(lines, fmt.Sprintf("• `/%s`: %s", path, strings.Join(roles, ", ")))
+	}
+
+	sort.Strings(lines)
+
+	ctx.Reply(customisation.Green, i18n.TitleManageCommands, i18n.MessageManageCommandsList, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,35 @@
+package settings
+
+import (
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type PlaceholdersCommand struct {
+}
+
+func (PlaceholdersCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "placeholders",
+		Description:     i18n.HelpPlaceholders,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Children: []registry.Command{
+			PlaceholdersPreviewCommand{},
+		},
+		Category:         command.Settings,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+	}
+}
+
+func (c PlaceholdersCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PlaceholdersCommand) Execute(_ registry.CommandContext) {
+	// Cannot call parent command
+}
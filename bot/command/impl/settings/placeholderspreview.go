@@ -0,0 +1,316 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/tags"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+const (
+	maxPreviewResponseLength = 500
+
+	// Discord's limits on embeds. The total length is shared by every embed in the message, so the report is given
+	// whatever the rendered message leaves over.
+	maxEmbedFieldValueLength = 1024
+	maxEmbedFields           = 25
+	maxEmbedsTotalLength     = 6000
+
+	// minReportFieldValueLength is the shortest that a field is truncated to before it is left out entirely
+	minReportFieldValueLength = 32
+)
+
+type PlaceholdersPreviewCommand struct {
+}
+
+func (c PlaceholdersPreviewCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "preview",
+		Description:     i18n.HelpPlaceholdersPreview,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalAutocompleteableArgument("tag", i18n.ArgumentPlaceholdersPreviewTag, interaction.OptionTypeString, i18n.MessageTagInvalidTag, tags.TagCommand{}.AutoCompleteHandler),
+			command.NewOptionalAutocompleteableArgument("panel", i18n.ArgumentPlaceholdersPreviewPanel, interaction.OptionTypeInteger, i18n.MessagePlaceholdersInvalidPanel, c.PanelAutoCompleteHandler),
+		),
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 15,
+	}
+}
+
+func (c PlaceholdersPreviewCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute renders a tag, or a panel's welcome message, or the default welcome message if neither is given
+func (PlaceholdersPreviewCommand) Execute(ctx registry.CommandContext, tagId *string, panelId *int) {
+	if tagId != nil && panelId != nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePlaceholdersTagAndPanel)
+		return
+	}
+
+	ticket, isTicket, err := logic.GetPreviewTicket(ctx, ctx)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var sources []string
+	var render func(additionalPlaceholders map[string]string) (string, []*embed.Embed, error)
+
+	if tagId != nil {
+		tag, ok, err := dbclient.Client.Tag.Get(ctx, ctx.GuildId(), *tagId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !ok {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTagInvalidTag)
+			return
+		}
+
+		sources = []string{utils.ValueOrZero(tag.Content)}
+		if tag.Embed != nil {
+			sources = append(sources, logic.GetCustomEmbedSources(*tag.Embed.CustomEmbed, tag.Embed.Fields)...)
+		}
+
+		render = func(additionalPlaceholders map[string]string) (string, []*embed.Embed, error) {
+			content := logic.DoPlaceholderSubstitutions(ctx, utils.ValueOrZero(tag.Content), ctx.Worker(), ticket, additionalPlaceholders)
+
+			var embeds []*embed.Embed
+			if tag.Embed != nil {
+				embeds = append(embeds, logic.BuildCustomEmbed(ctx, ctx.Worker(), ticket, *tag.Embed.CustomEmbed, tag.Embed.Fields, additionalPlaceholders))
+			}
+
+			return content, embeds, nil
+		}
+	} else {
+		var panel *database.Panel
+		subject := ctx.GetMessage(i18n.Ticket)
+
+		if panelId != nil {
+			p, err := dbclient.Client.Panel.GetById(ctx, *panelId)
+			if err != nil {
+				ctx.HandleError(err)
+				return
+			}
+
+			if p.PanelId == 0 || p.GuildId != ctx.GuildId() {
+				ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePlaceholdersInvalidPanel)
+				return
+			}
+
+			panel = &p
+			subject = p.Title
+		}
+
+		sources, err = logic.GetWelcomeMessageSources(ctx, ctx.GuildId(), panel)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		render = func(additionalPlaceholders map[string]string) (string, []*embed.Embed, error) {
+			e, err := logic.BuildWelcomeMessageEmbed(ctx, ctx, ticket, subject, panel, additionalPlaceholders)
+			if err != nil {
+				return "", nil, err
+			}
+
+			return "", []*embed.Embed{e}, nil
+		}
+	}
+
+	preview, err := logic.PreviewPlaceholders(ctx, ctx.Worker(), ticket, sources)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	content, embeds, err := render(preview.AdditionalPlaceholders)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	renderedLength := 0
+	for _, e := range embeds {
+		renderedLength += embedLength(e)
+	}
+
+	embeds = append(embeds, buildPlaceholderReport(ctx, preview, isTicket, maxEmbedsTotalLength-renderedLength))
+
+	// The preview is only shown to the admin, so nobody should be pinged by it
+	if _, err := ctx.ReplyWith(command.MessageResponse{
+		Content:         content,
+		Embeds:          embeds,
+		AllowedMentions: message.AllowedMention{},
+		Flags:           message.SumFlags(message.FlagEphemeral),
+	}); err != nil {
+		ctx.HandleError(err)
+	}
+}
+
+// buildPlaceholderReport lists the problems found with the placeholders, followed by the integration responses and the
+// available placeholders. Fields are added in that order while they fit in the remaining length, so the most useful
+// information is kept if the report has to be cut short.
+func buildPlaceholderReport(ctx registry.CommandContext, preview logic.PlaceholderPreview, isTicket bool, remaining int) *embed.Embed {
+	var notes []string
+	if !isTicket {
+		notes = append(notes, ctx.GetMessage(i18n.MessagePlaceholdersSampleTicket))
+	}
+
+	// The preview makes real requests, which may be served from or fill the cache, and count towards the breaker
+	if len(preview.Integrations) > 0 {
+		notes = append(notes, ctx.GetMessage(i18n.MessagePlaceholdersLiveIntegrations))
+	}
+
+	e := utils.BuildEmbedRaw(ctx.GetColour(customisation.Green), ctx.GetMessage(i18n.TitlePlaceholders), strings.Join(notes, "\n\n"), nil)
+	remaining -= embedLength(e)
+
+	addField := func(name, value string) {
+		if len(e.Fields) == maxEmbedFields {
+			return
+		}
+
+		maxLength := min(maxEmbedFieldValueLength, remaining-utf8.RuneCountInString(name))
+		if maxLength < minReportFieldValueLength {
+			return
+		}
+
+		value = utils.TruncateRunes(value, maxLength, "...")
+		e.AddField(name, value, false)
+		remaining -= utf8.RuneCountInString(name) + utf8.RuneCountInString(value)
+	}
+
+	problems := false
+
+	if len(preview.Unknown) > 0 {
+		problems = true
+
+		names := make([]string, len(preview.Unknown))
+		for i, name := range preview.Unknown {
+			names[i] = fmt.Sprintf("`%%%s%%`", name)
+		}
+
+		addField(ctx.GetMessage(i18n.MessagePlaceholdersUnknown), strings.Join(names, ", "))
+	}
+
+	if len(preview.Failing) > 0 {
+		problems = true
+
+		lines := make([]string, len(preview.Failing))
+		for i, failure := range preview.Failing {
+			lines[i] = fmt.Sprintf("`%%%s%%`: %s", failure.Name, failure.Reason)
+		}
+
+		addField(ctx.GetMessage(i18n.MessagePlaceholdersFailing), strings.Join(lines, "\n"))
+	}
+
+	if preview.TemplatesDisabled {
+		problems = true
+		addField(ctx.GetMessage(i18n.MessagePlaceholdersTemplateError), ctx.GetMessage(i18n.MessagePlaceholdersTemplatesDisabled))
+	} else if preview.TemplateError != nil {
+		problems = true
+		addField(ctx.GetMessage(i18n.MessagePlaceholdersTemplateError), fmt.Sprintf("`%s`", preview.TemplateError.Error()))
+	}
+
+	if !problems {
+		addField(ctx.GetMessage(i18n.TitlePlaceholders), ctx.GetMessage(i18n.MessagePlaceholdersNoProblems))
+	}
+
+	// Raw responses help integration authors fix their placeholder paths
+	for _, integration := range preview.Integrations {
+		var value string
+		if integration.Err != nil {
+			value = ctx.GetMessage(i18n.MessagePlaceholdersIntegrationFailed, integration.Err.Error())
+		}
+
+		if integration.Response != "" {
+			value += fmt.Sprintf("\n```json\n%s\n```", utils.TruncateRunes(integration.Response, maxPreviewResponseLength, "..."))
+		} else if integration.Err == nil {
+			value = ctx.GetMessage(i18n.MessagePlaceholdersIntegrationNoBody)
+		}
+
+		addField(utils.TruncateRunes(integration.Name, 256, "..."), strings.TrimSpace(value))
+	}
+
+	lines := make([]string, len(preview.Available))
+	for i, placeholder := range preview.Available {
+		lines[i] = fmt.Sprintf("`%%%s%%`: %s", placeholder.Name, placeholder.Value)
+	}
+
+	addField(ctx.GetMessage(i18n.MessagePlaceholdersAvailable), strings.Join(lines, "\n"))
+
+	return e
+}
+
+// embedLength counts the characters of an embed that Discord includes in the total length of a message's embeds
+func embedLength(e *embed.Embed) int {
+	length := utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Description)
+	if e.Footer != nil {
+		length += utf8.RuneCountInString(e.Footer.Text)
+	}
+
+	if e.Author != nil {
+		length += utf8.RuneCountInString(e.Author.Name)
+	}
+
+	for _, field := range e.Fields {
+		length += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+	}
+
+	return length
+}
+
+// PanelAutoCompleteHandler suggests the guild's panels, whose welcome message can be previewed
+func (PlaceholdersPreviewCommand) PanelAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+	defer cancel()
+
+	panels, err := dbclient.Client.Panel.GetByGuild(ctx, data.GuildId.Value)
+	if err != nil {
+		fmt.Print(err, errorcontext.WorkerErrorContext{Guild: data.GuildId.Value})
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, panel := range panels {
+		if value != "" && !strings.Contains(strings.ToLower(panel.Title), strings.ToLower(value)) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  panel.Title,
+			Value: panel.PanelId,
+		})
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
@@ -0,0 +1,131 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type AccessCheckSetupCommand struct{}
+
+func (c AccessCheckSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "accesscheck",
+		Description:     i18n.HelpSetupAccessCheck,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", i18n.ArgumentSetupAccessCheckPanel, interaction.OptionTypeInteger, i18n.SetupAccessCheckInvalidPanel, AppealsSetupCommand{}.AutoCompleteHandler),
+			command.NewOptionalAutocompleteableArgument("integration", i18n.ArgumentSetupAccessCheckIntegration, interaction.OptionTypeInteger, i18n.SetupAccessCheckInvalidIntegration, integrationAutoCompleteHandler),
+			command.NewOptionalArgument("allow_on_error", i18n.ArgumentSetupAccessCheckAllowOnError, interaction.OptionTypeBoolean, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c AccessCheckSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AccessCheckSetupCommand) Execute(ctx registry.CommandContext, panelId int, integrationId *int, allowOnError *bool) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify panel is from same guild
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupAccessCheckInvalidPanel)
+		return
+	}
+
+	// Omitting the integration removes the access check
+	if integrationId == nil {
+		if err := dbclient.Local.PanelAccessIntegrations.Delete(ctx, panel.PanelId); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAccessCheckRemoved, panel.Title)
+		return
+	}
+
+	// Only integrations activated in the guild may be used, as they are the only ones with secrets set
+	guildIntegrations, err := dbclient.Client.CustomIntegrationGuilds.GetGuildIntegrations(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var integrationName string
+	for _, integration := range guildIntegrations {
+		if integration.Id == *integrationId {
+			integrationName = integration.Name
+			break
+		}
+	}
+
+	if integrationName == "" {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupAccessCheckInvalidIntegration)
+		return
+	}
+
+	accessIntegration := dbclient.PanelAccessIntegration{
+		PanelId:       panel.PanelId,
+		IntegrationId: *integrationId,
+		AllowOnError:  allowOnError != nil && *allowOnError,
+	}
+
+	if err := dbclient.Local.PanelAccessIntegrations.Set(ctx, accessIntegration); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAccessCheckSuccess, integrationName, panel.Title)
+}
+
+// integrationAutoCompleteHandler suggests the custom integrations activated in the guild
+func integrationAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+	defer cancel()
+
+	integrations, err := dbclient.Client.CustomIntegrationGuilds.GetGuildIntegrations(ctx, data.GuildId.Value)
+	if err != nil {
+		fmt.Print(err) // TODO: Context
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, integration := range integrations {
+		if value != "" && !strings.Contains(strings.ToLower(integration.Name), strings.ToLower(value)) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  integration.Name,
+			Value: integration.Id,
+		})
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
@@ -0,0 +1,101 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type AppealsSetupCommand struct{}
+
+func (c AppealsSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "appeals",
+		Description:     i18n.HelpSetupAppeals,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalAutocompleteableArgument("panel", i18n.ArgumentSetupAppealsPanel, interaction.OptionTypeInteger, i18n.SetupAppealsInvalidPanel, c.AutoCompleteHandler),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c AppealsSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AppealsSetupCommand) Execute(ctx registry.CommandContext, panelId *int) {
+	if panelId == nil {
+		if err := dbclient.Local.BlacklistAppealPanel.Delete(ctx, ctx.GuildId()); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAppealsDisabled)
+		return
+	}
+
+	panel, err := dbclient.Client.Panel.GetById(ctx, *panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify panel is from same guild
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupAppealsInvalidPanel)
+		return
+	}
+
+	if err := dbclient.Local.BlacklistAppealPanel.Set(ctx, ctx.GuildId(), panel.PanelId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAppealsSuccess, panel.Title)
+}
+
+func (AppealsSetupCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+	defer cancel()
+
+	panels, err := dbclient.Client.Panel.GetByGuild(ctx, data.GuildId.Value)
+	if err != nil {
+		fmt.Print(err) // TODO: Context
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, panel := range panels {
+		if value != "" && !strings.Contains(strings.ToLower(panel.Title), strings.ToLower(value)) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  panel.Title,
+			Value: panel.PanelId,
+		})
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
@@ -11,12 +11,12 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/channel/embed"
 	"github.com/rxdn/gdl/objects/interaction"
-	channel_permissions "github.com/rxdn/gdl/permission"
 	"github.com/rxdn/gdl/rest"
 )
 
@@ -89,7 +89,7 @@ func (AutoSetupCommand) Execute(ctx registry.CommandContext) {
 	ctx.ReplyWithEmbed(embed)
 
 	// create transcripts channel
-	switch transcriptChannel, err := ctx.Worker().CreateGuildChannel(ctx.GuildId(), getTranscriptChannelData(ctx.GuildId(), supportRoleId, adminRoleId)); err {
+	switch transcriptChannel, err := ctx.Worker().CreateGuildChannel(ctx.GuildId(), logic.PrivateStaffChannelData("transcripts", ctx.GuildId(), supportRoleId, adminRoleId)); err {
 	case nil:
 		messageContent += fmt.Sprintf("\n✅ %s", i18n.GetMessageFromGuild(ctx.GuildId(), i18n.SetupAutoTranscriptChannelSuccess, transcriptChannel.Id))
 
@@ -159,46 +159,3 @@ func getColour(ctx context.Context, guildId uint64, failed bool) int {
 	hex, _ := customisation.GetColour(ctx, guildId, colour)
 	return hex
 }
-
-func getTranscriptChannelData(guildId, supportRoleId, adminRoleId uint64) rest.CreateChannelData {
-	allow := channel_permissions.BuildPermissions(
-		channel_permissions.ViewChannel,
-		channel_permissions.SendMessages,
-		channel_permissions.EmbedLinks,
-		channel_permissions.AttachFiles,
-		channel_permissions.ReadMessageHistory,
-	)
-
-	overwrites := []channel.PermissionOverwrite{
-		{ // deny everyone else access to channel
-			Id:    guildId,
-			Type:  channel.PermissionTypeRole,
-			Allow: 0,
-			Deny:  allow,
-		},
-	}
-
-	if supportRoleId != 0 {
-		overwrites = append(overwrites, channel.PermissionOverwrite{
-			Id:    supportRoleId,
-			Type:  channel.PermissionTypeRole,
-			Allow: allow,
-			Deny:  0,
-		})
-	}
-
-	if adminRoleId != 0 {
-		overwrites = append(overwrites, channel.PermissionOverwrite{
-			Id:    adminRoleId,
-			Type:  channel.PermissionTypeRole,
-			Allow: allow,
-			Deny:  0,
-		})
-	}
-
-	return rest.CreateChannelData{
-		Name:                 "transcripts",
-		Type:                 channel.ChannelTypeGuildText,
-		PermissionOverwrites: overwrites,
-	}
-}
@@ -0,0 +1,75 @@
+package setup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type FeedbackSetupCommand struct{}
+
+func (FeedbackSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "feedback",
+		Description:     i18n.HelpSetupFeedback,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("scale", i18n.ArgumentSetupFeedbackScale, interaction.OptionTypeString, "infallible").
+				WithChoices(
+					interaction.ApplicationCommandOptionChoice{Name: "1-5 Stars", Value: string(dbclient.RatingScaleStars)},
+					interaction.ApplicationCommandOptionChoice{Name: "1-10 (NPS)", Value: string(dbclient.RatingScaleNps)},
+					interaction.ApplicationCommandOptionChoice{Name: "Thumbs Up / Down", Value: string(dbclient.RatingScaleThumbs)},
+				),
+			command.NewOptionalArgument("reason_threshold", i18n.ArgumentSetupFeedbackReasonThreshold, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(1).
+				WithMaxValue(10),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c FeedbackSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute sets the rating scale, and the highest score for which users are asked for a reason. If no threshold is
+// given, users are never asked for a reason.
+func (FeedbackSetupCommand) Execute(ctx registry.CommandContext, scale string, reasonThreshold *int) {
+	settings := dbclient.FeedbackSettings{
+		Scale:           dbclient.RatingScale(scale),
+		ReasonThreshold: reasonThreshold,
+	}
+
+	if !logic.IsValidRatingScale(settings.Scale) {
+		ctx.HandleError(fmt.Errorf("invalid rating scale %s", scale))
+		return
+	}
+
+	maxScore := logic.RatingScaleMax(settings.Scale)
+	if reasonThreshold != nil && (*reasonThreshold < 1 || *reasonThreshold > maxScore) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFeedbackInvalidThreshold, maxScore)
+		return
+	}
+
+	if err := dbclient.Local.FeedbackSettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if reasonThreshold == nil {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupFeedbackSuccess, scale)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupFeedbackSuccessWithReason, scale, logic.FormatRating(settings.Scale, *reasonThreshold))
+	}
+}
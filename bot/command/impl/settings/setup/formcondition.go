@@ -0,0 +1,107 @@
+package setup
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type FormConditionSetupCommand struct{}
+
+func (c FormConditionSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "formcondition",
+		Description:     i18n.HelpSetupFormCondition,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("input", i18n.ArgumentSetupFormConditionInput, interaction.OptionTypeInteger, i18n.SetupFormValidationInvalidInput, FormValidationSetupCommand{}.AutoCompleteHandler),
+			command.NewOptionalAutocompleteableArgument("depends_on", i18n.ArgumentSetupFormConditionDependsOn, interaction.OptionTypeInteger, i18n.SetupFormValidationInvalidInput, FormValidationSetupCommand{}.AutoCompleteHandler),
+			command.NewOptionalArgument("operator", i18n.ArgumentSetupFormConditionOperator, interaction.OptionTypeString, "infallible").
+				WithChoices(
+					interaction.ApplicationCommandOptionChoice{Name: "Equals", Value: string(dbclient.FormConditionEquals)},
+					interaction.ApplicationCommandOptionChoice{Name: "Does not equal", Value: string(dbclient.FormConditionNotEquals)},
+					interaction.ApplicationCommandOptionChoice{Name: "Contains", Value: string(dbclient.FormConditionContains)},
+				),
+			command.NewOptionalArgument("value", i18n.ArgumentSetupFormConditionValue, interaction.OptionTypeString, "infallible").
+				WithMaxLength(255),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c FormConditionSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormConditionSetupCommand) Execute(ctx registry.CommandContext, inputId int, dependsOnId *int, operator, value *string) {
+	input, ok, err := getGuildFormInput(ctx, ctx.GuildId(), inputId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationInvalidInput)
+		return
+	}
+
+	// Omitting the input to depend on removes the condition, so the question is always asked
+	if dependsOnId == nil {
+		if err := dbclient.Local.FormInputConditions.Delete(ctx, input.Id); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupFormConditionRemoved, input.Label)
+		return
+	}
+
+	dependency, ok, err := getGuildFormInput(ctx, ctx.GuildId(), *dependsOnId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationInvalidInput)
+		return
+	}
+
+	// The answer must be known before the question is asked
+	if dependency.FormId != input.FormId || dependency.Position >= input.Position {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormConditionInvalidDependency, input.Label, dependency.Label)
+		return
+	}
+
+	if value == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormConditionMissingValue)
+		return
+	}
+
+	condition := dbclient.FormInputCondition{
+		FormInputId:      input.Id,
+		DependsOnInputId: dependency.Id,
+		Operator:         dbclient.FormConditionEquals,
+		Value:            *value,
+	}
+
+	if operator != nil {
+		condition.Operator = dbclient.FormConditionOperator(*operator)
+	}
+
+	if err := dbclient.Local.FormInputConditions.Set(ctx, condition); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupFormConditionSuccess, input.Label, dependency.Label)
+}
@@ -0,0 +1,196 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// formValidationNone removes the rules from an input
+const formValidationNone = "none"
+
+type FormValidationSetupCommand struct{}
+
+func (c FormValidationSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "formvalidation",
+		Description:     i18n.HelpSetupFormValidation,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("input", i18n.ArgumentSetupFormValidationInput, interaction.OptionTypeInteger, i18n.SetupFormValidationInvalidInput, c.AutoCompleteHandler),
+			command.NewRequiredArgument("type", i18n.ArgumentSetupFormValidationType, interaction.OptionTypeString, "infallible").
+				WithChoices(
+					interaction.ApplicationCommandOptionChoice{Name: "None", Value: formValidationNone},
+					interaction.ApplicationCommandOptionChoice{Name: "Text", Value: string(dbclient.FormValidationText)},
+					interaction.ApplicationCommandOptionChoice{Name: "Regex", Value: string(dbclient.FormValidationRegex)},
+					interaction.ApplicationCommandOptionChoice{Name: "Integer", Value: string(dbclient.FormValidationInteger)},
+					interaction.ApplicationCommandOptionChoice{Name: "Decimal", Value: string(dbclient.FormValidationDecimal)},
+					interaction.ApplicationCommandOptionChoice{Name: "Email", Value: string(dbclient.FormValidationEmail)},
+					interaction.ApplicationCommandOptionChoice{Name: "URL", Value: string(dbclient.FormValidationUrl)},
+					interaction.ApplicationCommandOptionChoice{Name: "Discord ID", Value: string(dbclient.FormValidationSnowflake)},
+				),
+			command.NewOptionalArgument("pattern", i18n.ArgumentSetupFormValidationPattern, interaction.OptionTypeString, "infallible").
+				WithMaxLength(255),
+			command.NewOptionalArgument("min_length", i18n.ArgumentSetupFormValidationMinLength, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(0).
+				WithMaxValue(4000),
+			command.NewOptionalArgument("max_length", i18n.ArgumentSetupFormValidationMaxLength, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(1).
+				WithMaxValue(4000),
+			command.NewOptionalArgument("min_value", i18n.ArgumentSetupFormValidationMinValue, interaction.OptionTypeNumber, "infallible"),
+			command.NewOptionalArgument("max_value", i18n.ArgumentSetupFormValidationMaxValue, interaction.OptionTypeNumber, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c FormValidationSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormValidationSetupCommand) Execute(
+	ctx registry.CommandContext,
+	inputId int,
+	validationType string,
+	pattern *string,
+	minLength, maxLength *int,
+	minValue, maxValue *float64,
+) {
+	input, ok, err := getGuildFormInput(ctx, ctx.GuildId(), inputId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationInvalidInput)
+		return
+	}
+
+	if validationType == formValidationNone {
+		if err := dbclient.Local.FormInputValidation.Delete(ctx, input.Id); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupFormValidationRemoved, input.Label)
+		return
+	}
+
+	rule := dbclient.FormInputValidation{
+		FormInputId: input.Id,
+		Type:        dbclient.FormValidationType(validationType),
+		MinLength:   minLength,
+		MaxLength:   maxLength,
+	}
+
+	if rule.Type == dbclient.FormValidationRegex {
+		if pattern == nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationMissingPattern)
+			return
+		}
+
+		if _, err := regexp.Compile(*pattern); err != nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationInvalidPattern, err.Error())
+			return
+		}
+
+		rule.Pattern = pattern
+	}
+
+	if rule.Type == dbclient.FormValidationInteger || rule.Type == dbclient.FormValidationDecimal {
+		rule.MinValue = minValue
+		rule.MaxValue = maxValue
+	} else if minValue != nil || maxValue != nil {
+		// Rather than silently ignoring the range, which the admin would expect to be enforced
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationNotNumeric)
+		return
+	}
+
+	if (minLength != nil && maxLength != nil && *minLength > *maxLength) ||
+		(rule.MinValue != nil && rule.MaxValue != nil && *rule.MinValue > *rule.MaxValue) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationInvalidRange)
+		return
+	}
+
+	if err := dbclient.Local.FormInputValidation.Set(ctx, rule); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupFormValidationSuccess, input.Label)
+}
+
+func (FormValidationSetupCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+	defer cancel()
+
+	inputs, err := dbclient.Client.FormInput.GetAllInputsByCustomId(ctx, data.GuildId.Value)
+	if err != nil {
+		fmt.Print(err) // TODO: Context
+		return nil
+	}
+
+	sorted := make([]database.FormInput, 0, len(inputs))
+	for _, input := range inputs {
+		if value == "" || strings.Contains(strings.ToLower(input.Label), strings.ToLower(value)) {
+			sorted = append(sorted, input)
+		}
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].FormId != sorted[j].FormId {
+			return sorted[i].FormId < sorted[j].FormId
+		}
+
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	if len(sorted) > 25 {
+		sorted = sorted[:25]
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, len(sorted))
+	for i, input := range sorted {
+		choices[i] = interaction.ApplicationCommandOptionChoice{
+			Name:  input.Label,
+			Value: input.Id,
+		}
+	}
+
+	return choices
+}
+
+func getGuildFormInput(ctx context.Context, guildId uint64, inputId int) (database.FormInput, bool, error) {
+	inputs, err := dbclient.Client.FormInput.GetAllInputsByCustomId(ctx, guildId)
+	if err != nil {
+		return database.FormInput{}, false, err
+	}
+
+	for _, input := range inputs {
+		if input.Id == inputId {
+			return input, true, nil
+		}
+	}
+
+	return database.FormInput{}, false, nil
+}
@@ -0,0 +1,110 @@
+package setup
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type IntegrationSetupCommand struct{}
+
+func (IntegrationSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "integration",
+		Description:     i18n.HelpSetupIntegration,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("integration", i18n.ArgumentSetupIntegrationIntegration, interaction.OptionTypeInteger, i18n.SetupAccessCheckInvalidIntegration, integrationAutoCompleteHandler),
+			command.NewOptionalArgument("cache_ttl", i18n.ArgumentSetupIntegrationCacheTtl, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(0).
+				WithMaxValue(86400),
+			command.NewOptionalArgument("timeout", i18n.ArgumentSetupIntegrationTimeout, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(500).
+				WithMaxValue(10000),
+			command.NewOptionalArgument("failure_threshold", i18n.ArgumentSetupIntegrationThreshold, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(1).
+				WithMaxValue(100),
+			command.NewOptionalArgument("open_duration", i18n.ArgumentSetupIntegrationOpenDuration, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(10).
+				WithMaxValue(3600),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c IntegrationSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute changes how the worker calls a custom integration for this guild. Settings that are not given keep their current value,
+// so running the command with only the integration shows its settings.
+func (IntegrationSetupCommand) Execute(
+	ctx registry.CommandContext,
+	integrationId int,
+	cacheTtlSeconds, timeoutMs, failureThreshold, openDurationSeconds *int,
+) {
+	// Only integrations activated in the guild may be configured
+	guildIntegrations, err := dbclient.Client.CustomIntegrationGuilds.GetGuildIntegrations(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var integrationName string
+	for _, integration := range guildIntegrations {
+		if integration.Id == integrationId {
+			integrationName = integration.Name
+			break
+		}
+	}
+
+	if integrationName == "" {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupAccessCheckInvalidIntegration)
+		return
+	}
+
+	settings, err := dbclient.Local.CustomIntegrationSettings.Get(ctx, ctx.GuildId(), integrationId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if cacheTtlSeconds != nil {
+		settings.CacheTtl = time.Duration(*cacheTtlSeconds) * time.Second
+	}
+
+	if timeoutMs != nil {
+		settings.Timeout = time.Duration(*timeoutMs) * time.Millisecond
+	}
+
+	if failureThreshold != nil {
+		settings.FailureThreshold = *failureThreshold
+	}
+
+	if openDurationSeconds != nil {
+		settings.OpenDuration = time.Duration(*openDurationSeconds) * time.Second
+	}
+
+	if err := dbclient.Local.CustomIntegrationSettings.Set(ctx, settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupIntegrationSuccess,
+		integrationName,
+		int(settings.CacheTtl.Seconds()),
+		settings.Timeout.Milliseconds(),
+		settings.FailureThreshold,
+		int(settings.FailureWindow.Seconds()),
+		int(settings.OpenDuration.Seconds()),
+	)
+}
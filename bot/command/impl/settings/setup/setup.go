@@ -19,10 +19,21 @@ func (SetupCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Admin,
 		Category:        command.Settings,
 		Children: []registry.Command{
+			AccessCheckSetupCommand{},
+			AppealsSetupCommand{},
 			AutoSetupCommand{},
+			FeedbackSetupCommand{},
+			FormConditionSetupCommand{},
+			FormValidationSetupCommand{},
+			IntegrationSetupCommand{},
 			LimitSetupCommand{},
+			SurveySelectSetupCommand{},
+			TemplatesSetupCommand{},
+			TimezoneSetupCommand{},
 			TranscriptsSetupCommand{},
 			ThreadsSetupCommand{},
+			WebhookSetupCommand{},
+			WizardSetupCommand{},
 		},
 	}
 }
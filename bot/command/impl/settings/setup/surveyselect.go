@@ -0,0 +1,118 @@
+package setup
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// maxSurveySelectOptionLength is the length Discord allows for a select menu option
+const maxSurveySelectOptionLength = 100
+
+type SurveySelectSetupCommand struct{}
+
+func (SurveySelectSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "surveyselect",
+		Description:     i18n.HelpSetupSurveySelect,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("input", i18n.ArgumentSetupSurveySelectInput, interaction.OptionTypeInteger, i18n.SetupFormValidationInvalidInput, FormValidationSetupCommand{}.AutoCompleteHandler),
+			command.NewOptionalArgument("options", i18n.ArgumentSetupSurveySelectOptions, interaction.OptionTypeString, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c SurveySelectSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute makes an exit survey question answered from a select menu with the given comma separated options. If no
+// options are given, the question is answered in a text box again.
+func (SurveySelectSetupCommand) Execute(ctx registry.CommandContext, inputId int, options *string) {
+	input, ok, err := getGuildFormInput(ctx, ctx.GuildId(), inputId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupFormValidationInvalidInput)
+		return
+	}
+
+	if options == nil {
+		if err := dbclient.Local.ExitSurveySelectOptions.Delete(ctx, input.Id); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupSurveySelectRemoved, input.Label)
+		return
+	}
+
+	var parsed []string
+	for _, option := range strings.Split(*options, ",") {
+		option = strings.TrimSpace(option)
+		if option == "" || utils.Contains(parsed, option) {
+			continue
+		}
+
+		if utf8.RuneCountInString(option) > maxSurveySelectOptionLength {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupSurveySelectInvalidOptions, dbclient.MaxExitSurveySelectOptions, maxSurveySelectOptionLength)
+			return
+		}
+
+		parsed = append(parsed, option)
+	}
+
+	if len(parsed) == 0 || len(parsed) > dbclient.MaxExitSurveySelectOptions {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupSurveySelectInvalidOptions, dbclient.MaxExitSurveySelectOptions, maxSurveySelectOptionLength)
+		return
+	}
+
+	// Only a limited number of select menus fit in the message the questions are asked in
+	formInputs, err := dbclient.Client.FormInput.GetInputs(ctx, input.FormId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var otherInputIds []int
+	for _, formInput := range formInputs {
+		if formInput.Id != input.Id {
+			otherInputIds = append(otherInputIds, formInput.Id)
+		}
+	}
+
+	existing, err := dbclient.Local.ExitSurveySelectOptions.GetAll(ctx, otherInputIds)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(existing) >= dbclient.MaxExitSurveySelectQuestions {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupSurveySelectTooManyQuestions, dbclient.MaxExitSurveySelectQuestions)
+		return
+	}
+
+	if err := dbclient.Local.ExitSurveySelectOptions.Set(ctx, input.Id, parsed); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupSurveySelectSuccess, input.Label, strings.Join(parsed, ", "))
+}
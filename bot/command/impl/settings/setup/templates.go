@@ -0,0 +1,47 @@
+package setup
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type TemplatesSetupCommand struct{}
+
+func (TemplatesSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "templates",
+		Description:     i18n.HelpSetupTemplates,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("enabled", i18n.ArgumentSetupTemplatesEnabled, interaction.OptionTypeBoolean, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c TemplatesSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (TemplatesSetupCommand) Execute(ctx registry.CommandContext, enabled bool) {
+	if err := dbclient.Local.TemplatesEnabled.Set(ctx, ctx.GuildId(), enabled); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if enabled {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupTemplatesEnabled)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupTemplatesDisabled)
+	}
+}
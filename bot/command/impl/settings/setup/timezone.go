@@ -0,0 +1,62 @@
+package setup
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type TimezoneSetupCommand struct{}
+
+func (TimezoneSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "timezone",
+		Description:     i18n.HelpSetupTimezone,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("timezone", i18n.ArgumentSetupTimezoneTimezone, interaction.OptionTypeString, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c TimezoneSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute sets the guild's timezone, or resets it to UTC if no timezone is given
+func (TimezoneSetupCommand) Execute(ctx registry.CommandContext, timezone *string) {
+	if timezone == nil {
+		if err := dbclient.Local.GuildTimezones.Delete(ctx, ctx.GuildId()); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupTimezoneReset)
+		return
+	}
+
+	location, err := utils.LoadTimezone(strings.TrimSpace(*timezone))
+	if err != nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupTimezoneInvalid, *timezone)
+		return
+	}
+
+	if err := dbclient.Local.GuildTimezones.Set(ctx, ctx.GuildId(), location.String()); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupTimezoneSet, location.String(), utils.FormatDateTime(time.Now(), location))
+}
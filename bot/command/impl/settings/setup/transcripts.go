@@ -10,6 +10,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest/request"
 )
@@ -25,7 +26,8 @@ func (TranscriptsSetupCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Admin,
 		Category:        command.Settings,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("channel", "The channel that ticket transcripts should be sent to", interaction.OptionTypeChannel, i18n.SetupTranscriptsInvalid),
+			command.NewRequiredArgument("channel", i18n.ArgumentSetupTranscriptsChannel, interaction.OptionTypeChannel, i18n.SetupTranscriptsInvalid).
+				WithChannelTypes(channel.ChannelTypeGuildText),
 		),
 		Timeout: time.Second * 5,
 	}
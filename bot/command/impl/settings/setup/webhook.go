@@ -0,0 +1,143 @@
+package setup
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type WebhookSetupCommand struct{}
+
+func (WebhookSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "webhook",
+		Description:     i18n.HelpSetupWebhook,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("url", i18n.ArgumentSetupWebhookUrl, interaction.OptionTypeString, "infallible").
+				WithMaxLength(255),
+			command.NewOptionalArgument("events", i18n.ArgumentSetupWebhookEvents, interaction.OptionTypeString, "infallible").
+				WithMaxLength(255),
+			command.NewOptionalArgument("remove", i18n.ArgumentSetupWebhookRemove, interaction.OptionTypeBoolean, "infallible"),
+			command.NewOptionalArgument("regenerate_secret", i18n.ArgumentSetupWebhookRegenerateSecret, interaction.OptionTypeBoolean, "infallible"),
+		),
+		InteractionOnly:  true,
+		DefaultEphemeral: true, // The reply may contain the signing secret
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c WebhookSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (WebhookSetupCommand) Execute(ctx registry.CommandContext, webhookUrl string, eventList *string, remove, regenerateSecret *bool) {
+	if remove != nil && *remove {
+		ok, err := dbclient.Local.OutboundWebhooks.Delete(ctx, ctx.GuildId(), webhookUrl)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !ok {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupWebhookNotFound)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupWebhookRemoved)
+		return
+	}
+
+	parsed, err := url.Parse(webhookUrl)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupWebhookInvalidUrl)
+		return
+	}
+
+	// No events subscribes to all of them, including any added in the future
+	var events []string
+	if eventList != nil {
+		for _, event := range strings.Split(*eventList, ",") {
+			event = strings.ToLower(strings.TrimSpace(event))
+			if event == "" || event == "all" {
+				continue
+			}
+
+			if !webhooks.IsEvent(event) {
+				ctx.Reply(customisation.Red, i18n.Error, i18n.SetupWebhookInvalidEvent, event, joinEvents())
+				return
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	existing, err := dbclient.Local.OutboundWebhooks.GetByGuild(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	isNew := true
+	for _, webhook := range existing {
+		if webhook.Url == webhookUrl {
+			isNew = false
+			break
+		}
+	}
+
+	if isNew && len(existing) >= webhooks.MaxWebhooksPerGuild {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupWebhookLimit, webhooks.MaxWebhooksPerGuild)
+		return
+	}
+
+	if err := dbclient.Local.OutboundWebhooks.Set(ctx, ctx.GuildId(), webhookUrl, events); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// The secret is only shown when it is generated, so it must be regenerated if it is lost
+	_, hasSecret, err := dbclient.Local.OutboundWebhookSecrets.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !hasSecret || (regenerateSecret != nil && *regenerateSecret) {
+		secret, err := webhooks.GenerateSecret()
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if err := dbclient.Local.OutboundWebhookSecrets.Set(ctx, ctx.GuildId(), secret); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupWebhookSuccessWithSecret, secret)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupWebhookSuccess)
+}
+
+func joinEvents() string {
+	names := make([]string, len(webhooks.Events))
+	for i, event := range webhooks.Events {
+		names[i] = string(event)
+	}
+
+	return strings.Join(names, ", ")
+}
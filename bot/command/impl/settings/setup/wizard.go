@@ -0,0 +1,52 @@
+package setup
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type WizardSetupCommand struct{}
+
+func (WizardSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "wizard",
+		Description:      i18n.HelpSetupWizard,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Admin,
+		Category:         command.Settings,
+		DefaultEphemeral: true,
+		InteractionOnly:  true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c WizardSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute starts the wizard. The choices are kept in the component state store until the final step is confirmed, so
+// that an abandoned wizard leaves the guild's settings untouched.
+func (WizardSetupCommand) Execute(ctx registry.CommandContext) {
+	wizard := logic.NewSetupWizardState()
+
+	token, err := state.Save(ctx, ctx.GuildId(), ctx.UserId(), wizard, state.DefaultExpiry)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	e, components, err := logic.BuildSetupWizardMessage(ctx, token, &wizard)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, components))
+}
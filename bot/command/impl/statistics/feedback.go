@@ -0,0 +1,200 @@
+package statistics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/interaction"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// feedbackSummaryDays is the period that the score distribution is calculated over
+	feedbackSummaryDays = 30
+	// feedbackRecentReasons is the number of recent rating reasons shown in the summary
+	feedbackRecentReasons = 5
+)
+
+type FeedbackCommand struct {
+}
+
+func (FeedbackCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "feedback",
+		Description:     i18n.HelpFeedback,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Statistics,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("ticket", i18n.ArgumentFeedbackTicket, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(1),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 10,
+	}
+}
+
+func (c FeedbackCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute shows the feedback given for a ticket, or a summary of the guild's recent feedback if no ticket is given
+func (c FeedbackCommand) Execute(ctx registry.CommandContext, ticketId *int) {
+	if ticketId == nil {
+		c.executeSummary(ctx)
+	} else {
+		c.executeTicket(ctx, *ticketId)
+	}
+}
+
+func (FeedbackCommand) executeSummary(ctx registry.CommandContext) {
+	settings, err := dbclient.Local.FeedbackSettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var counts map[int]int
+	var recent []dbclient.FeedbackRating
+
+	group, _ := errgroup.WithContext(ctx)
+
+	// load counts
+	group.Go(func() (err error) {
+		since := time.Now().AddDate(0, 0, -feedbackSummaryDays)
+		counts, err = dbclient.Local.FeedbackRatings.GetScoreCounts(ctx, ctx.GuildId(), settings.Scale, since)
+		return
+	})
+
+	// load recent
+	group.Go(func() (err error) {
+		recent, err = dbclient.Local.FeedbackRatings.GetRecentWithReasons(ctx, ctx.GuildId(), feedbackRecentReasons)
+		return
+	})
+
+	if err := group.Wait(); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	maxScore := logic.RatingScaleMax(settings.Scale)
+
+	var total, sum, promoters, detractors int
+	var distribution strings.Builder
+	for score := maxScore; score >= 1; score-- {
+		count := counts[score]
+		total += count
+		sum += score * count
+
+		if score >= 9 {
+			promoters += count
+		} else if score <= 6 {
+			detractors += count
+		}
+
+		distribution.WriteString(fmt.Sprintf("%s: %d\n", logic.FormatRating(settings.Scale, score), count))
+	}
+
+	msgEmbed := embed.NewEmbed().
+		SetTitle("Feedback").
+		SetColor(ctx.GetColour(customisation.Green)).
+		AddField("Scale", string(settings.Scale), true).
+		AddField(fmt.Sprintf("Ratings (%d days)", feedbackSummaryDays), fmt.Sprint(total), true)
+
+	if total > 0 {
+		switch settings.Scale {
+		case dbclient.RatingScaleNps:
+			// Net promoter score: the percentage of 9-10 scores, minus the percentage of 1-6 scores
+			nps := (promoters - detractors) * 100 / total
+			msgEmbed.AddField("NPS", fmt.Sprint(nps), true)
+		case dbclient.RatingScaleThumbs:
+			msgEmbed.AddField("Positive", fmt.Sprintf("%d%%", counts[2]*100/total), true)
+		default:
+			msgEmbed.AddField("Average", fmt.Sprintf("%.2f", float64(sum)/float64(total)), true)
+		}
+	}
+
+	msgEmbed.AddField("Distribution", distribution.String(), false)
+
+	for _, rating := range recent {
+		msgEmbed.AddField(
+			fmt.Sprintf("Ticket #%d (%s)", rating.TicketId, logic.FormatRating(rating.Scale, rating.Score)),
+			utils.StringMax(*rating.Reason, 1024, "..."),
+			false,
+		)
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
+}
+
+func (FeedbackCommand) executeTicket(ctx registry.CommandContext, ticketId int) {
+	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 || ticket.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFeedbackTicketNotFound, ticketId)
+		return
+	}
+
+	rating, err := logic.GetFeedbackRating(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	surveyResponse, err := dbclient.Client.ExitSurveyResponses.GetResponses(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if rating == nil && len(surveyResponse.Responses) == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFeedbackNoFeedback, ticket.Id)
+		return
+	}
+
+	msgEmbed := embed.NewEmbed().
+		SetTitle(fmt.Sprintf("Feedback for Ticket #%d", ticket.Id)).
+		SetColor(ctx.GetColour(customisation.Green))
+
+	if rating != nil {
+		msgEmbed.AddField("Rating", logic.FormatRating(rating.Scale, rating.Score), true)
+
+		if rating.Reason != nil {
+			msgEmbed.AddField("Reason", utils.StringMax(*rating.Reason, 1024, "..."), false)
+		}
+	}
+
+	for _, answer := range surveyResponse.Responses {
+		var title string
+		if answer.Question == nil {
+			title = "Unknown Question"
+		} else {
+			title = *answer.Question
+		}
+
+		var response string
+		if len(answer.Response) > 0 {
+			response = utils.StringMax(answer.Response, 1024, "...")
+		} else {
+			response = "No response"
+		}
+
+		msgEmbed.AddField(title, response, false)
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
+}
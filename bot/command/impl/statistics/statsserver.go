@@ -11,6 +11,8 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -88,10 +90,10 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 		return
 	})
 
-	// tickets per day
+	// tickets per day, where days start at midnight in the guild's timezone
 	var ticketVolumeTable string
 	group.Go(func() error {
-		counts, err := dbclient.Analytics.GetLastNTicketsPerDayGuild(ctx, ctx.GuildId(), 7)
+		rows, err := getTicketVolume(ctx, ctx.GuildId())
 		if err != nil {
 			return err
 		}
@@ -101,8 +103,8 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 		tw.Style().Format.Header = text.FormatDefault
 
 		tw.AppendHeader(table.Row{"Date", "Ticket Volume"})
-		for _, count := range counts {
-			tw.AppendRow(table.Row{count.Date.Format("2006-01-02"), count.Count})
+		for _, row := range rows {
+			tw.AppendRow(row)
 		}
 
 		ticketVolumeTable = tw.Render()
@@ -134,6 +136,52 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
 }
 
+// getTicketVolume returns the number of tickets opened on each of the last 7 days. The analytics database counts days
+// in UTC, so it is used unless the guild has chosen another timezone, in which case the tickets are counted from the
+// main database instead. Timezones that Postgres does not know fall back to the analytics database.
+func getTicketVolume(ctx registry.CommandContext, guildId uint64) ([]table.Row, error) {
+	location, err := logic.GetGuildLocation(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	if location != time.UTC {
+		known, err := dbclient.Local.TicketVolume.IsKnownTimezone(ctx, location.String())
+		if err != nil {
+			return nil, err
+		}
+
+		if known {
+			counts, err := dbclient.Local.TicketVolume.GetLastNDays(ctx, guildId, 7, location)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([]table.Row, len(counts))
+			for i, count := range counts {
+				rows[i] = table.Row{count.Date.Format("2006-01-02"), count.Count}
+			}
+
+			return rows, nil
+		}
+
+		err = fmt.Errorf("timezone %q is not known to postgres, counting ticket volume in UTC", location.String())
+		fmt.Print(err, errorcontext.WorkerErrorContext{Guild: guildId})
+	}
+
+	counts, err := dbclient.Analytics.GetLastNTicketsPerDayGuild(ctx, guildId, 7)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]table.Row, len(counts))
+	for i, count := range counts {
+		rows[i] = table.Row{count.Date.Format("2006-01-02"), count.Count}
+	}
+
+	return rows, nil
+}
+
 func formatNullableTime(duration *time.Duration) string {
 	return utils.FormatNullableTime(duration)
 }
@@ -29,7 +29,7 @@ func (StatsUserCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Support,
 		Category:        command.Statistics,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("user", "User whose statistics to retrieve", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+			command.NewRequiredArgument("user", i18n.ArgumentStatsUser, interaction.OptionTypeUser, i18n.MessageInvalidUser),
 		),
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 30,
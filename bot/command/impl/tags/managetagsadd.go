@@ -28,8 +28,9 @@ func (ManageTagsAddCommand) Properties() registry.Properties {
 		Category:        command.Tags,
 		InteractionOnly: true,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("id", "Identifier for the tag", interaction.OptionTypeString, i18n.MessageTagCreateInvalidArguments),
-			command.NewRequiredArgument("content", "Tag contents to be sent when /tag is used", interaction.OptionTypeString, i18n.MessageTagCreateInvalidArguments),
+			command.NewRequiredArgument("id", i18n.ArgumentManageTagsAddId, interaction.OptionTypeString, i18n.MessageTagCreateInvalidArguments).
+				WithMaxLength(16),
+			command.NewRequiredArgument("content", i18n.ArgumentManageTagsAddContent, interaction.OptionTypeString, i18n.MessageTagCreateInvalidArguments),
 		),
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 3,
@@ -59,12 +60,6 @@ func (ManageTagsAddCommand) Execute(ctx registry.CommandContext, tagId, content
 		return
 	}
 
-	// Length check
-	if len(tagId) > 16 {
-		ctx.ReplyWithFields(customisation.Red, i18n.Error, i18n.MessageTagCreateTooLong, utils.ToSlice(usageEmbed))
-		return
-	}
-
 	// Verify a tag with the ID doesn't already exist
 	exists, err := dbclient.Client.Tag.Exists(ctx, ctx.GuildId(), tagId)
 	if err != nil {
@@ -31,7 +31,7 @@ func (c TagCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Everyone,
 		Category:        command.Tags,
 		Arguments: command.Arguments(
-			command.NewRequiredAutocompleteableArgument("id", "The ID of the tag to be sent to the channel", interaction.OptionTypeString, i18n.MessageTagInvalidArguments, c.AutoCompleteHandler),
+			command.NewRequiredAutocompleteableArgument("id", i18n.ArgumentTagId, interaction.OptionTypeString, i18n.MessageTagInvalidArguments, c.AutoCompleteHandler),
 		),
 		Timeout: time.Second * 5,
 	}
@@ -1,6 +1,8 @@
 package tickets
 
 import (
+	"fmt"
+
 	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
@@ -8,6 +10,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest/request"
@@ -24,7 +27,7 @@ func (AddCommand) Properties() registry.Properties {
 		PermissionLevel: permcache.Everyone,
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("user", "User to add to the ticket", interaction.OptionTypeUser, i18n.MessageAddNoMembers),
+			command.NewRequiredArgument("user", i18n.ArgumentAddUser, interaction.OptionTypeUser, i18n.MessageAddNoMembers),
 		),
 		Timeout: constants.TimeoutOpenTicket,
 	}
@@ -97,5 +100,11 @@ func (AddCommand) Execute(ctx registry.CommandContext, userId uint64) {
 		}
 	}
 
+	if err := webhooks.Dispatch(ctx, ctx.GuildId(), ticket.Id, webhooks.EventMemberAdded, ctx.UserId(), webhooks.MemberData{
+		UserId: userId,
+	}); err != nil {
+		fmt.Print(err, ctx.ToErrorContext())
+	}
+
 	ctx.ReplyPermanent(customisation.Green, i18n.TitleAdd, i18n.MessageAddSuccess, userId, *ticket.ChannelId)
 }
@@ -32,8 +32,11 @@ func (c CloseRequestCommand) Properties() registry.Properties {
 		Category:        command.Tickets,
 		InteractionOnly: true,
 		Arguments: command.Arguments(
-			command.NewOptionalArgument("close_delay", "Hours to close the ticket in if the user does not respond", interaction.OptionTypeInteger, "infallible"),
-			command.NewOptionalAutocompleteableArgument("reason", "The reason the ticket was closed", interaction.OptionTypeString, "infallible", c.ReasonAutoCompleteHandler),
+			command.NewOptionalArgument("close_delay", i18n.ArgumentCloseRequestCloseDelay, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(1).
+				WithMaxValue(720),
+			command.NewOptionalAutocompleteableArgument("reason", i18n.ArgumentCloseRequestReason, interaction.OptionTypeString, "infallible", c.ReasonAutoCompleteHandler).
+				WithMaxLength(255),
 		),
 		Timeout: time.Second * 5,
 	}
@@ -55,11 +58,6 @@ func (CloseRequestCommand) Execute(ctx registry.CommandContext, closeDelay *int,
 		return
 	}
 
-	if reason != nil && len(*reason) > 255 {
-		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCloseReasonTooLong)
-		return
-	}
-
 	var closeAt *time.Time = nil
 	if closeDelay != nil {
 		tmp := time.Now().Add(time.Hour * time.Duration(*closeDelay))
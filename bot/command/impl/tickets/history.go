@@ -0,0 +1,99 @@
+package tickets
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type HistoryCommand struct {
+}
+
+func (HistoryCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "history",
+		Description:      i18n.HelpHistory,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		DefaultEphemeral: true,
+		InteractionOnly:  true,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("user", i18n.ArgumentHistoryUser, interaction.OptionTypeUser, i18n.MessageInvalidUser),
+			command.NewOptionalAutocompleteableArgument("panel", i18n.ArgumentHistoryPanel, interaction.OptionTypeInteger, i18n.MessageInvalidArgument, SwitchPanelCommand{}.AutoCompleteHandler),
+		),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (c HistoryCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (HistoryCommand) Execute(ctx registry.CommandContext, userId uint64, panelId *int) {
+	sendTicketHistory(ctx, userId, panelId)
+}
+
+type ViewTicketHistoryCommand struct {
+}
+
+func (ViewTicketHistoryCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "View ticket history",
+		Type:             interaction.ApplicationCommandTypeUser,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		DefaultEphemeral: true,
+		InteractionOnly:  true,
+		Timeout:          time.Second * 10,
+	}
+}
+
+func (c ViewTicketHistoryCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ViewTicketHistoryCommand) Execute(ctx registry.CommandContext) {
+	interaction, ok := ctx.(*context.SlashCommandContext)
+	if !ok {
+		return
+	}
+
+	userId := interaction.Interaction.Data.TargetId
+	if userId == 0 {
+		ctx.HandleError(errors.New("Target user missing from interaction data"))
+		return
+	}
+
+	sendTicketHistory(ctx, userId, nil)
+}
+
+func sendTicketHistory(ctx registry.CommandContext, userId uint64, panelId *int) {
+	history := logic.TicketHistoryState{
+		UserId:   ctx.UserId(),
+		TargetId: userId,
+		PanelId:  panelId,
+	}
+
+	token, err := state.Save(ctx, ctx.GuildId(), ctx.UserId(), history, state.DefaultExpiry)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	e, components, err := logic.BuildTicketHistoryMessage(ctx, ctx, token, history)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, components))
+}
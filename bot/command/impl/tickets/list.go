@@ -0,0 +1,106 @@
+package tickets
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/model"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type TicketsListCommand struct {
+}
+
+func (TicketsListCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "list",
+		Description:      i18n.HelpTicketsList,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		DefaultEphemeral: true,
+		InteractionOnly:  true,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("claimed_by", i18n.ArgumentTicketsListClaimedBy, interaction.OptionTypeString, "infallible").
+				WithChoices(
+					interaction.ApplicationCommandOptionChoice{Name: "Me", Value: "me"},
+					interaction.ApplicationCommandOptionChoice{Name: "Unclaimed", Value: "unclaimed"},
+				),
+			command.NewOptionalArgument("claimer", i18n.ArgumentTicketsListClaimer, interaction.OptionTypeUser, i18n.MessageInvalidUser),
+			command.NewOptionalAutocompleteableArgument("panel", i18n.ArgumentTicketsListPanel, interaction.OptionTypeInteger, i18n.MessageInvalidArgument, SwitchPanelCommand{}.AutoCompleteHandler),
+			command.NewOptionalArgument("status", i18n.ArgumentTicketsListStatus, interaction.OptionTypeString, "infallible").
+				WithChoices(
+					interaction.ApplicationCommandOptionChoice{Name: "Open", Value: "open"},
+					interaction.ApplicationCommandOptionChoice{Name: "Pending", Value: "pending"},
+				),
+			command.NewOptionalArgument("older_than", i18n.ArgumentTicketsListOlderThan, interaction.OptionTypeInteger, "infallible").
+				WithMinValue(1).
+				WithMaxValue(8760),
+			command.NewOptionalArgument("opener", i18n.ArgumentTicketsListOpener, interaction.OptionTypeUser, i18n.MessageInvalidUser),
+		),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (c TicketsListCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute lists the open tickets matching the filters. The filters are kept in the component state store, so that the
+// page and quick action buttons can re-render the list.
+func (TicketsListCommand) Execute(ctx registry.CommandContext, claimedBy *string, claimerId *uint64, panelId *int, status *string, olderThan *int, openerId *uint64) {
+	filter := logic.TicketListFilter{
+		PanelId:  panelId,
+		OpenerId: openerId,
+	}
+
+	// A specific claimer takes precedence over the claimed_by choice
+	if claimerId != nil {
+		filter.ClaimerId = claimerId
+	} else if claimedBy != nil {
+		switch *claimedBy {
+		case "me":
+			filter.ClaimerId = utils.Ptr(ctx.UserId())
+		case "unclaimed":
+			filter.Unclaimed = true
+		}
+	}
+
+	if status != nil {
+		switch *status {
+		case "open":
+			filter.Status = utils.Ptr(model.TicketStatusOpen)
+		case "pending":
+			filter.Status = utils.Ptr(model.TicketStatusPending)
+		}
+	}
+
+	if olderThan != nil {
+		filter.OlderThan = utils.Ptr(time.Hour * time.Duration(*olderThan))
+	}
+
+	list := logic.TicketListState{
+		UserId: ctx.UserId(),
+		Filter: filter,
+	}
+
+	token, err := state.Save(ctx, ctx.GuildId(), ctx.UserId(), list, state.DefaultExpiry)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	e, components, err := logic.BuildTicketListMessage(ctx, ctx, token, list)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, components))
+}
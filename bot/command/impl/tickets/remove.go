@@ -1,6 +1,7 @@
 package tickets
 
 import (
+	"fmt"
 	"time"
 
 	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
@@ -10,6 +11,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/interaction"
@@ -27,7 +29,7 @@ func (RemoveCommand) Properties() registry.Properties {
 		PermissionLevel: permcache.Everyone,
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("user", "User to remove from the current ticket", interaction.OptionTypeUser, i18n.MessageRemoveAdminNoMembers),
+			command.NewRequiredArgument("user", i18n.ArgumentRemoveUser, interaction.OptionTypeUser, i18n.MessageRemoveAdminNoMembers),
 		),
 		Timeout: time.Second * 8,
 	}
@@ -107,5 +109,11 @@ func (RemoveCommand) Execute(ctx registry.CommandContext, userId uint64) {
 		}
 	}
 
+	if err := webhooks.Dispatch(ctx, ctx.GuildId(), ticket.Id, webhooks.EventMemberRemoved, ctx.UserId(), webhooks.MemberData{
+		UserId: userId,
+	}); err != nil {
+		fmt.Print(err, ctx.ToErrorContext())
+	}
+
 	ctx.ReplyPermanent(customisation.Green, i18n.TitleRemove, i18n.MessageRemoveSuccess, userId, ctx.ChannelId())
 }
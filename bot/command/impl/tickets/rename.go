@@ -1,6 +1,7 @@
 package tickets
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
@@ -10,6 +11,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel/embed"
 	"github.com/rxdn/gdl/objects/interaction"
@@ -27,7 +29,8 @@ func (RenameCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Support,
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("name", "New name for the ticket", interaction.OptionTypeString, i18n.MessageRenameMissingName),
+			command.NewRequiredArgument("name", i18n.ArgumentRenameName, interaction.OptionTypeString, i18n.MessageRenameMissingName).
+				WithMaxLength(100),
 		),
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 5,
@@ -57,11 +60,6 @@ func (RenameCommand) Execute(ctx registry.CommandContext, name string) {
 		return
 	}
 
-	if len(name) > 100 {
-		ctx.Reply(customisation.Red, i18n.TitleRename, i18n.MessageRenameTooLong)
-		return
-	}
-
 	allowed, err := redis.TakeRenameRatelimit(ctx, ctx.ChannelId())
 	if err != nil {
 		ctx.HandleError(err)
@@ -82,5 +80,11 @@ func (RenameCommand) Execute(ctx registry.CommandContext, name string) {
 		return
 	}
 
+	if err := webhooks.Dispatch(ctx, ctx.GuildId(), ticket.Id, webhooks.EventRenamed, ctx.UserId(), webhooks.RenameData{
+		Name: name,
+	}); err != nil {
+		fmt.Print(err, ctx.ToErrorContext())
+	}
+
 	ctx.Reply(customisation.Green, i18n.TitleRename, i18n.MessageRenamed, ctx.ChannelId())
 }
@@ -0,0 +1,110 @@
+package tickets
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+const (
+	searchDateLayout = "2006-01-02"
+
+	// searchMinQueryLength is checked again after trimming, as Discord counts whitespace towards the length
+	searchMinQueryLength = 2
+)
+
+type TicketsSearchCommand struct {
+}
+
+func (TicketsSearchCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "search",
+		Description:      i18n.HelpTicketsSearch,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		DefaultEphemeral: true,
+		InteractionOnly:  true,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("query", i18n.ArgumentTicketsSearchQuery, interaction.OptionTypeString, "infallible").
+				WithMinLength(searchMinQueryLength).
+				WithMaxLength(100),
+			command.NewOptionalArgument("after", i18n.ArgumentTicketsSearchAfter, interaction.OptionTypeString, "infallible").
+				WithMaxLength(10),
+			command.NewOptionalArgument("before", i18n.ArgumentTicketsSearchBefore, interaction.OptionTypeString, "infallible").
+				WithMaxLength(10),
+			command.NewOptionalArgument("staff", i18n.ArgumentTicketsSearchStaff, interaction.OptionTypeUser, i18n.MessageInvalidUser),
+		),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (c TicketsSearchCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (TicketsSearchCommand) Execute(ctx registry.CommandContext, query string, after, before *string, staffId *uint64) {
+	// A query of only whitespace would otherwise match every ticket
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < searchMinQueryLength {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageArgumentTooShort, "query", searchMinQueryLength)
+		return
+	}
+
+	search := logic.TicketSearchState{
+		Query:   query,
+		StaffId: staffId,
+	}
+
+	// Dates are given in the guild's timezone
+	location, err := logic.GetGuildLocation(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if after != nil {
+		date, err := time.ParseInLocation(searchDateLayout, *after, location)
+		if err != nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTicketSearchInvalidDate, *after)
+			return
+		}
+
+		search.After = &date
+	}
+
+	if before != nil {
+		date, err := time.ParseInLocation(searchDateLayout, *before, location)
+		if err != nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTicketSearchInvalidDate, *before)
+			return
+		}
+
+		// Include tickets opened on the day itself
+		date = date.AddDate(0, 0, 1)
+		search.Before = &date
+	}
+
+	token, err := state.Save(ctx, ctx.GuildId(), ctx.UserId(), search, state.DefaultExpiry)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	e, components, err := logic.BuildTicketSearchMessage(ctx, ctx, token, search)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, components))
+}
@@ -0,0 +1,34 @@
+package tickets
+
+import (
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type TicketsCommand struct {
+}
+
+func (TicketsCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "tickets",
+		Description:     i18n.HelpTickets,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Children: []registry.Command{
+			TicketsListCommand{},
+			TicketsSearchCommand{},
+		},
+	}
+}
+
+func (c TicketsCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (TicketsCommand) Execute(ctx registry.CommandContext) {
+	// Parent commands cannot be called
+}
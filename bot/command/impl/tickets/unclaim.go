@@ -1,6 +1,8 @@
 package tickets
 
 import (
+	"fmt"
+
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	"github.com/jadevelopmentgrp/Tickets-Database"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
@@ -10,6 +12,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest"
@@ -82,6 +85,12 @@ func (UnclaimCommand) Execute(ctx *context.SlashCommandContext) {
 		return
 	}
 
+	if err := webhooks.Dispatch(ctx, ctx.GuildId(), ticket.Id, webhooks.EventUnclaimed, ctx.UserId(), webhooks.ClaimData{
+		ClaimerId: whoClaimed,
+	}); err != nil {
+		fmt.Print(err, ctx.ToErrorContext())
+	}
+
 	// get panel
 	var panel *database.Panel
 	if ticket.PanelId != nil {
@@ -10,7 +10,6 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
-	"github.com/rxdn/gdl/rest"
 )
 
 type CommandManager struct {
@@ -36,12 +35,15 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["autoclose"] = settings.AutoCloseCommand{}
 	cm.registry["blacklist"] = settings.BlacklistCommand{}
 	cm.registry["language"] = settings.LanguageCommand{}
+	cm.registry["managecommands"] = settings.ManageCommandsCommand{Registry: cm.registry}
 	cm.registry["panel"] = settings.PanelCommand{}
+	cm.registry["placeholders"] = settings.PlaceholdersCommand{}
 	cm.registry["removeadmin"] = settings.RemoveAdminCommand{}
 	cm.registry["removesupport"] = settings.RemoveSupportCommand{}
 	cm.registry["setup"] = setup.SetupCommand{}
 	cm.registry["viewstaff"] = settings.ViewStaffCommand{}
 
+	cm.registry["feedback"] = statistics.FeedbackCommand{}
 	cm.registry["stats"] = statistics.StatsCommand{}
 
 	cm.registry["managetags"] = tags.ManageTagsCommand{}
@@ -51,6 +53,7 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["claim"] = tickets.ClaimCommand{}
 	cm.registry["close"] = tickets.CloseCommand{}
 	cm.registry["closerequest"] = tickets.CloseRequestCommand{}
+	cm.registry["history"] = tickets.HistoryCommand{}
 	cm.registry["notes"] = tickets.NotesCommand{}
 	cm.registry["on-call"] = tickets.OnCallCommand{}
 	cm.registry["open"] = tickets.OpenCommand{}
@@ -59,8 +62,10 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["rename"] = tickets.RenameCommand{}
 	cm.registry["reopen"] = tickets.ReopenCommand{}
 	cm.registry["switchpanel"] = tickets.SwitchPanelCommand{}
+	cm.registry["tickets"] = tickets.TicketsCommand{}
 	cm.registry["transfer"] = tickets.TransferCommand{}
 	cm.registry["unclaim"] = tickets.UnclaimCommand{}
+	cm.registry["View ticket history"] = tickets.ViewTicketHistoryCommand{}
 }
 
 func (cm *CommandManager) RunSetupFuncs() {
@@ -71,26 +76,29 @@ func (cm *CommandManager) RunSetupFuncs() {
 	}
 }
 
-func (cm *CommandManager) BuildCreatePayload() (data []rest.CreateCommandData) {
+func (cm *CommandManager) BuildCreatePayload(isWhitelabel bool) (data []CommandCreateData) {
 	for _, cmd := range cm.GetCommands() {
 		properties := cmd.Properties()
 
-		if properties.MessageOnly {
+		if properties.MessageOnly || (isWhitelabel && properties.MainBotOnly) {
 			continue
 		}
 
-		option := buildOption(cmd)
+		option := buildOption(cmd, nil)
 
-		var description string
-		if properties.Type == interaction.ApplicationCommandTypeChatInput {
-			description = option.Description
+		cmdData := CommandCreateData{
+			Name:    option.Name,
+			Options: option.Options,
+			Type:    properties.Type,
 		}
 
-		cmdData := rest.CreateCommandData{
-			Name:        option.Name,
-			Description: description,
-			Options:     option.Options,
-			Type:        properties.Type,
+		// Context menu commands have neither a description nor a restricted name format
+		if properties.Type == interaction.ApplicationCommandTypeChatInput {
+			cmdData.NameLocalizations = option.NameLocalizations
+			cmdData.Description = option.Description
+			cmdData.DescriptionLocalizations = option.DescriptionLocalizations
+		} else {
+			cmdData.NameLocalizations = i18n.GetLocalisations(i18n.CommandNameId(properties.Name))
 		}
 
 		data = append(data, cmdData)
@@ -99,19 +107,20 @@ func (cm *CommandManager) BuildCreatePayload() (data []rest.CreateCommandData) {
 	return data
 }
 
-func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
+func buildOption(cmd registry.Command, parentPath []string) CommandOption {
 	properties := cmd.Properties()
+	path := append(append([]string{}, parentPath...), properties.Name)
 
 	// Required args must come before optional args
-	var required []interaction.ApplicationCommandOption
-	var optional []interaction.ApplicationCommandOption
+	var required []CommandOption
+	var optional []CommandOption
 
 	for _, child := range properties.Children {
 		if child.Properties().MessageOnly {
 			continue
 		}
 
-		option := buildOption(child)
+		option := buildOption(child, path)
 
 		if option.Required {
 			required = append(required, option)
@@ -121,15 +130,22 @@ func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
 	}
 
 	for _, argument := range properties.Arguments {
-		option := interaction.ApplicationCommandOption{
-			Type:         argument.Type,
-			Name:         argument.Name,
-			Description:  argument.Description,
-			Default:      false,
-			Required:     argument.Required,
-			Choices:      nil,
-			Autocomplete: argument.AutoCompleteHandler != nil,
-			Options:      nil,
+		option := CommandOption{
+			Type:                     argument.Type,
+			Name:                     argument.Name,
+			NameLocalizations:        filterNameLocalisations(i18n.GetLocalisations(i18n.ArgumentNameId(path, argument.Name))),
+			Description:              truncateDescription(i18n.GetMessage(i18n.LocaleEnglish, argument.Description)),
+			DescriptionLocalizations: filterDescriptionLocalisations(i18n.GetLocalisations(argument.Description)),
+			Default:                  false,
+			Required:                 argument.Required,
+			Choices:                  argument.Choices,
+			Autocomplete:             argument.AutoCompleteHandler != nil,
+			Options:                  nil,
+			ChannelTypes:             argument.ChannelTypes,
+			MinValue:                 argument.MinValue,
+			MaxValue:                 argument.MaxValue,
+			MinLength:                argument.MinLength,
+			MaxLength:                argument.MaxLength,
 		}
 
 		if option.Required {
@@ -141,13 +157,15 @@ func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
 
 	options := append(required, optional...)
 
-	return interaction.ApplicationCommandOption{
-		Type:        interaction.OptionTypeSubCommand,
-		Name:        properties.Name,
-		Description: i18n.GetMessage(i18n.LocaleEnglish, properties.Description),
-		Default:     false,
-		Required:    false,
-		Choices:     nil,
-		Options:     options,
+	return CommandOption{
+		Type:                     interaction.OptionTypeSubCommand,
+		Name:                     properties.Name,
+		NameLocalizations:        filterNameLocalisations(i18n.GetLocalisations(i18n.CommandNameId(path...))),
+		Description:              truncateDescription(i18n.GetMessage(i18n.LocaleEnglish, properties.Description)),
+		DescriptionLocalizations: filterDescriptionLocalisations(i18n.GetLocalisations(properties.Description)),
+		Default:                  false,
+		Required:                 false,
+		Choices:                  nil,
+		Options:                  options,
 	}
 }
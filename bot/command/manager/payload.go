@@ -0,0 +1,136 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+)
+
+// CommandCreateData mirrors rest.CreateCommandData, with the addition of localisations, which gdl does not support.
+// It is also used to decode the commands returned by Discord, as gdl's interaction.ApplicationCommand drops the type,
+// so that commands we do not own can be sent back unchanged.
+type CommandCreateData struct {
+	Id                       uint64                             `json:"id,string,omitempty"` // Optional: Use to rename without changing ID
+	Name                     string                             `json:"name"`
+	NameLocalizations        map[string]string                  `json:"name_localizations,omitempty"`
+	Description              string                             `json:"description"`
+	DescriptionLocalizations map[string]string                  `json:"description_localizations,omitempty"`
+	Options                  []CommandOption                    `json:"options"`
+	Type                     interaction.ApplicationCommandType `json:"type"`
+	DefaultMemberPermissions *string                            `json:"default_member_permissions,omitempty"`
+	DmPermission             *bool                              `json:"dm_permission,omitempty"`
+	Nsfw                     bool                               `json:"nsfw,omitempty"`
+}
+
+// CommandOption mirrors interaction.ApplicationCommandOption, with the addition of localisations and value constraints
+type CommandOption struct {
+	Type                     interaction.ApplicationCommandOptionType     `json:"type"`
+	Name                     string                                       `json:"name"`
+	NameLocalizations        map[string]string                            `json:"name_localizations,omitempty"`
+	Description              string                                       `json:"description"`
+	DescriptionLocalizations map[string]string                            `json:"description_localizations,omitempty"`
+	Default                  bool                                         `json:"default"`
+	Required                 bool                                         `json:"required"`
+	Choices                  []interaction.ApplicationCommandOptionChoice `json:"choices,omitempty"`
+	Autocomplete             bool                                         `json:"autocomplete"`
+	Options                  []CommandOption                              `json:"options,omitempty"`
+	ChannelTypes             []channel.ChannelType                        `json:"channel_types,omitempty"`
+	MinValue                 *float64                                     `json:"min_value,omitempty"`
+	MaxValue                 *float64                                     `json:"max_value,omitempty"`
+	MinLength                *int                                         `json:"min_length,omitempty"`
+	MaxLength                *int                                         `json:"max_length,omitempty"`
+}
+
+const maxDescriptionLength = 100
+
+// Discord rejects the entire payload if a single chat input command name is invalid, so we drop bad translations
+var chatInputNameRegex = regexp.MustCompile(`^[-_\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+func filterNameLocalisations(localisations map[string]string) map[string]string {
+	for locale, name := range localisations {
+		if name != strings.ToLower(name) || !chatInputNameRegex.MatchString(name) {
+			delete(localisations, locale)
+		}
+	}
+
+	if len(localisations) == 0 {
+		return nil
+	}
+
+	return localisations
+}
+
+func filterDescriptionLocalisations(localisations map[string]string) map[string]string {
+	for locale, description := range localisations {
+		localisations[locale] = truncateDescription(description)
+	}
+
+	return localisations
+}
+
+func truncateDescription(description string) string {
+	runes := []rune(description)
+	if len(runes) > maxDescriptionLength {
+		return string(runes[:maxDescriptionLength])
+	}
+
+	return description
+}
+
+func GetGlobalCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId uint64) (commands []CommandCreateData, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.GET,
+		ContentType: request.Nil,
+		Endpoint:    fmt.Sprintf("/applications/%d/commands?with_localizations=true", applicationId),
+		Route:       ratelimit.NewApplicationRoute(ratelimit.RouteGetGlobalCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, nil, &commands)
+	return
+}
+
+func GetGuildCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId, guildId uint64) (commands []CommandCreateData, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.GET,
+		ContentType: request.Nil,
+		Endpoint:    fmt.Sprintf("/applications/%d/guilds/%d/commands?with_localizations=true", applicationId, guildId),
+		Route:       ratelimit.NewGuildRoute(ratelimit.RouteGetGuildCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, nil, &commands)
+	return
+}
+
+func ModifyGlobalCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId uint64, data []CommandCreateData) (commands []CommandCreateData, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.PUT,
+		ContentType: request.ApplicationJson,
+		Endpoint:    fmt.Sprintf("/applications/%d/commands", applicationId),
+		Route:       ratelimit.NewApplicationRoute(ratelimit.RouteModifyGlobalCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, data, &commands)
+	return
+}
+
+func ModifyGuildCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId, guildId uint64, data []CommandCreateData) (commands []CommandCreateData, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.PUT,
+		ContentType: request.ApplicationJson,
+		Endpoint:    fmt.Sprintf("/applications/%d/guilds/%d/commands", applicationId, guildId),
+		Route:       ratelimit.NewGuildRoute(ratelimit.RouteModifyGuildCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, data, &commands)
+	return
+}
@@ -0,0 +1,63 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// BlacklistAppealPanelTable stores the panel that blacklisted users may still open tickets from, to appeal their
+// blacklist.
+type BlacklistAppealPanelTable struct {
+	*pgxpool.Pool
+}
+
+func newBlacklistAppealPanelTable(db *pgxpool.Pool) *BlacklistAppealPanelTable {
+	return &BlacklistAppealPanelTable{
+		db,
+	}
+}
+
+func (BlacklistAppealPanelTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS blacklist_appeal_panel(
+	"guild_id" int8 NOT NULL,
+	"panel_id" int4 NOT NULL,
+	FOREIGN KEY("panel_id") REFERENCES panels("panel_id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id")
+);`
+}
+
+func (t *BlacklistAppealPanelTable) Get(ctx context.Context, guildId uint64) (*int, error) {
+	query := `SELECT "panel_id" FROM blacklist_appeal_panel WHERE "guild_id" = $1;`
+
+	var panelId int
+	if err := t.QueryRow(ctx, query, guildId).Scan(&panelId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &panelId, nil
+}
+
+func (t *BlacklistAppealPanelTable) Set(ctx context.Context, guildId uint64, panelId int) error {
+	query := `
+INSERT INTO blacklist_appeal_panel("guild_id", "panel_id")
+VALUES($1, $2)
+ON CONFLICT("guild_id") DO UPDATE SET "panel_id" = $2;`
+
+	_, err := t.Exec(ctx, query, guildId, panelId)
+	return err
+}
+
+func (t *BlacklistAppealPanelTable) Delete(ctx context.Context, guildId uint64) error {
+	query := `DELETE FROM blacklist_appeal_panel WHERE "guild_id" = $1;`
+
+	_, err := t.Exec(ctx, query, guildId)
+	return err
+}
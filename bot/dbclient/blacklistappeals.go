@@ -0,0 +1,76 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// BlacklistAppealsTable records which tickets were opened by a blacklisted user to appeal their blacklist.
+type BlacklistAppealsTable struct {
+	*pgxpool.Pool
+}
+
+func newBlacklistAppealsTable(db *pgxpool.Pool) *BlacklistAppealsTable {
+	return &BlacklistAppealsTable{
+		db,
+	}
+}
+
+func (BlacklistAppealsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS blacklist_appeals(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"user_id" int8 NOT NULL,
+	FOREIGN KEY("ticket_id", "guild_id") REFERENCES tickets("id", "guild_id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+CREATE INDEX IF NOT EXISTS blacklist_appeals_guild_id_user_id ON blacklist_appeals("guild_id", "user_id");`
+}
+
+// Get returns the ID of the user who opened the appeal, if the ticket is an appeal
+func (t *BlacklistAppealsTable) Get(ctx context.Context, guildId uint64, ticketId int) (uint64, bool, error) {
+	query := `SELECT "user_id" FROM blacklist_appeals WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	var userId uint64
+	if err := t.QueryRow(ctx, query, guildId, ticketId).Scan(&userId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	return userId, true, nil
+}
+
+func (t *BlacklistAppealsTable) HasOpenAppeal(ctx context.Context, guildId, userId uint64) (bool, error) {
+	query := `
+SELECT EXISTS(
+	SELECT 1
+	FROM blacklist_appeals
+	INNER JOIN tickets
+		ON blacklist_appeals.guild_id = tickets.guild_id AND blacklist_appeals.ticket_id = tickets.id
+	WHERE blacklist_appeals.guild_id = $1 AND blacklist_appeals.user_id = $2 AND tickets.open = 't'
+);`
+
+	var exists bool
+	if err := t.QueryRow(ctx, query, guildId, userId).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (t *BlacklistAppealsTable) Create(ctx context.Context, guildId uint64, ticketId int, userId uint64) error {
+	query := `
+INSERT INTO blacklist_appeals("guild_id", "ticket_id", "user_id")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "ticket_id") DO NOTHING;`
+
+	_, err := t.Exec(ctx, query, guildId, ticketId, userId)
+	return err
+}
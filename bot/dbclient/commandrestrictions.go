@@ -0,0 +1,133 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// CommandRestrictionsTable stores the commands that a guild has disabled or limited to certain roles. Commands are
+// keyed by their full path, e.g. "setup limit", and a restriction on a parent command also applies to its children.
+type CommandRestrictionsTable struct {
+	*pgxpool.Pool
+}
+
+type CommandRestriction struct {
+	Command      string
+	Disabled     bool
+	AllowedRoles []uint64
+}
+
+func newCommandRestrictionsTable(db *pgxpool.Pool) *CommandRestrictionsTable {
+	return &CommandRestrictionsTable{
+		db,
+	}
+}
+
+func (CommandRestrictionsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS command_restrictions(
+	"guild_id" int8 NOT NULL,
+	"command" varchar(100) NOT NULL,
+	"disabled" bool NOT NULL DEFAULT 'f',
+	"allowed_roles" int8[] NOT NULL DEFAULT '{}',
+	PRIMARY KEY("guild_id", "command")
+);`
+}
+
+func (t *CommandRestrictionsTable) GetAll(ctx context.Context, guildId uint64) (map[string]CommandRestriction, error) {
+	query := `SELECT "command", "disabled", "allowed_roles" FROM command_restrictions WHERE "guild_id" = $1;`
+
+	rows, err := t.Query(ctx, query, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	restrictions := make(map[string]CommandRestriction)
+	for rows.Next() {
+		var restriction CommandRestriction
+		if err := rows.Scan(&restriction.Command, &restriction.Disabled, &restriction.AllowedRoles); err != nil {
+			return nil, err
+		}
+
+		restrictions[restriction.Command] = restriction
+	}
+
+	return restrictions, rows.Err()
+}
+
+// GetByCommands returns the restrictions on any of the given commands
+func (t *CommandRestrictionsTable) GetByCommands(ctx context.Context, guildId uint64, commands []string) ([]CommandRestriction, error) {
+	query := `
+SELECT "command", "disabled", "allowed_roles"
+FROM command_restrictions
+WHERE "guild_id" = $1 AND "command" = ANY($2);`
+
+	rows, err := t.Query(ctx, query, guildId, commands)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var restrictions []CommandRestriction
+	for rows.Next() {
+		var restriction CommandRestriction
+		if err := rows.Scan(&restriction.Command, &restriction.Disabled, &restriction.AllowedRoles); err != nil {
+			return nil, err
+		}
+
+		restrictions = append(restrictions, restriction)
+	}
+
+	return restrictions, rows.Err()
+}
+
+func (t *CommandRestrictionsTable) SetDisabled(ctx context.Context, guildId uint64, command string, disabled bool) error {
+	query := `
+INSERT INTO command_restrictions("guild_id", "command", "disabled")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "command") DO UPDATE SET "disabled" = $3;`
+
+	if _, err := t.Exec(ctx, query, guildId, command, disabled); err != nil {
+		return err
+	}
+
+	return t.deleteEmpty(ctx, guildId, command)
+}
+
+func (t *CommandRestrictionsTable) AddRole(ctx context.Context, guildId uint64, command string, roleId uint64) error {
+	query := `
+INSERT INTO command_restrictions("guild_id", "command", "allowed_roles")
+VALUES($1, $2, ARRAY[$3::int8])
+ON CONFLICT("guild_id", "command") DO UPDATE
+SET "allowed_roles" = array_append(array_remove(command_restrictions."allowed_roles", $3::int8), $3::int8);`
+
+	_, err := t.Exec(ctx, query, guildId, command, roleId)
+	return err
+}
+
+func (t *CommandRestrictionsTable) RemoveRole(ctx context.Context, guildId uint64, command string, roleId uint64) error {
+	query := `
+UPDATE command_restrictions
+SET "allowed_roles" = array_remove("allowed_roles", $3::int8)
+WHERE "guild_id" = $1 AND "command" = $2;`
+
+	if _, err := t.Exec(ctx, query, guildId, command, roleId); err != nil {
+		return err
+	}
+
+	return t.deleteEmpty(ctx, guildId, command)
+}
+
+// deleteEmpty removes the row if it no longer restricts the command in any way
+func (t *CommandRestrictionsTable) deleteEmpty(ctx context.Context, guildId uint64, command string) error {
+	query := `
+DELETE FROM command_restrictions
+WHERE "guild_id" = $1 AND "command" = $2 AND "disabled" = 'f' AND cardinality("allowed_roles") = 0;`
+
+	_, err := t.Exec(ctx, query, guildId, command)
+	return err
+}
@@ -0,0 +1,134 @@
+package dbclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// CustomIntegrationSettingsTable stores how the worker calls each custom integration for a guild: how long responses
+// are cached for, how long to wait for a response, and when to stop calling an integration that keeps failing. Public
+// integrations are shared between guilds, so settings are per guild, and one guild cannot change them for the others.
+// Integrations without a row use DefaultCustomIntegrationSettings.
+type CustomIntegrationSettingsTable struct {
+	*pgxpool.Pool
+}
+
+type CustomIntegrationSettings struct {
+	GuildId       uint64
+	IntegrationId int
+	// CacheTtl is how long GET responses are cached for. Zero disables caching.
+	CacheTtl time.Duration
+	Timeout  time.Duration
+	// FailureThreshold is the number of consecutive failures within FailureWindow after which the circuit breaker
+	// opens, and the integration is not called again until OpenDuration has passed
+	FailureThreshold int
+	FailureWindow    time.Duration
+	OpenDuration     time.Duration
+}
+
+func DefaultCustomIntegrationSettings(guildId uint64, integrationId int) CustomIntegrationSettings {
+	return CustomIntegrationSettings{
+		GuildId:          guildId,
+		IntegrationId:    integrationId,
+		CacheTtl:         0,
+		Timeout:          time.Second * 3,
+		FailureThreshold: 5,
+		FailureWindow:    time.Minute,
+		OpenDuration:     time.Minute * 2,
+	}
+}
+
+func newCustomIntegrationSettingsTable(db *pgxpool.Pool) *CustomIntegrationSettingsTable {
+	return &CustomIntegrationSettingsTable{
+		db,
+	}
+}
+
+func (CustomIntegrationSettingsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS custom_integration_settings(
+	"guild_id" int8 NOT NULL,
+	"integration_id" int4 NOT NULL,
+	"cache_ttl_seconds" int4 NOT NULL DEFAULT 0,
+	"timeout_ms" int4 NOT NULL DEFAULT 3000,
+	"failure_threshold" int4 NOT NULL DEFAULT 5,
+	"failure_window_seconds" int4 NOT NULL DEFAULT 60,
+	"open_seconds" int4 NOT NULL DEFAULT 120,
+	FOREIGN KEY("integration_id") REFERENCES custom_integrations("id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "integration_id")
+);`
+}
+
+// GetAll returns the guild's settings for each of the integrations, filling in the defaults for those without settings
+func (t *CustomIntegrationSettingsTable) GetAll(ctx context.Context, guildId uint64, integrationIds []int) (map[int]CustomIntegrationSettings, error) {
+	query := `
+SELECT "integration_id", "cache_ttl_seconds", "timeout_ms", "failure_threshold", "failure_window_seconds", "open_seconds"
+FROM custom_integration_settings
+WHERE "guild_id" = $1 AND "integration_id" = ANY($2);`
+
+	rows, err := t.Query(ctx, query, guildId, integrationIds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	settings := make(map[int]CustomIntegrationSettings)
+	for _, integrationId := range integrationIds {
+		settings[integrationId] = DefaultCustomIntegrationSettings(guildId, integrationId)
+	}
+
+	for rows.Next() {
+		var integrationId, cacheTtl, timeout, failureThreshold, failureWindow, openDuration int
+		if err := rows.Scan(&integrationId, &cacheTtl, &timeout, &failureThreshold, &failureWindow, &openDuration); err != nil {
+			return nil, err
+		}
+
+		settings[integrationId] = CustomIntegrationSettings{
+			GuildId:          guildId,
+			IntegrationId:    integrationId,
+			CacheTtl:         time.Duration(cacheTtl) * time.Second,
+			Timeout:          time.Duration(timeout) * time.Millisecond,
+			FailureThreshold: failureThreshold,
+			FailureWindow:    time.Duration(failureWindow) * time.Second,
+			OpenDuration:     time.Duration(openDuration) * time.Second,
+		}
+	}
+
+	return settings, rows.Err()
+}
+
+// Get returns the guild's settings for the integration, or the defaults if it has none
+func (t *CustomIntegrationSettingsTable) Get(ctx context.Context, guildId uint64, integrationId int) (CustomIntegrationSettings, error) {
+	settings, err := t.GetAll(ctx, guildId, []int{integrationId})
+	if err != nil {
+		return CustomIntegrationSettings{}, err
+	}
+
+	return settings[integrationId], nil
+}
+
+func (t *CustomIntegrationSettingsTable) Set(ctx context.Context, settings CustomIntegrationSettings) error {
+	query := `
+INSERT INTO custom_integration_settings("guild_id", "integration_id", "cache_ttl_seconds", "timeout_ms", "failure_threshold", "failure_window_seconds", "open_seconds")
+VALUES($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT("guild_id", "integration_id") DO UPDATE SET
+	"cache_ttl_seconds" = $3,
+	"timeout_ms" = $4,
+	"failure_threshold" = $5,
+	"failure_window_seconds" = $6,
+	"open_seconds" = $7;`
+
+	_, err := t.Exec(ctx, query,
+		settings.GuildId,
+		settings.IntegrationId,
+		int(settings.CacheTtl.Seconds()),
+		int(settings.Timeout.Milliseconds()),
+		settings.FailureThreshold,
+		int(settings.FailureWindow.Seconds()),
+		int(settings.OpenDuration.Seconds()),
+	)
+	return err
+}
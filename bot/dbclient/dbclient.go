@@ -38,4 +38,10 @@ func Connect(logger *zap.Logger) {
 	}
 
 	Client = database.NewDatabase(pool)
+	Local = newLocalDatabase(pool)
+
+	if err := Local.CreateTables(context.Background()); err != nil {
+		logger.Fatal("Failed to create worker tables", zap.Error(err))
+		return
+	}
 }
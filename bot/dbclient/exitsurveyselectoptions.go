@@ -0,0 +1,81 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// ExitSurveySelectOptionsTable stores the choices for exit survey questions that are answered from a select menu,
+// rather than in a text box. Discord modals can only contain text inputs, so these questions are asked in a message
+// before the modal is opened.
+type ExitSurveySelectOptionsTable struct {
+	*pgxpool.Pool
+}
+
+const (
+	// MaxExitSurveySelectOptions is the number of options Discord allows in a select menu
+	MaxExitSurveySelectOptions = 25
+	// MaxExitSurveySelectQuestions is the number of select menus that fit in a message, leaving a row for the
+	// continue button
+	MaxExitSurveySelectQuestions = 4
+)
+
+func newExitSurveySelectOptionsTable(db *pgxpool.Pool) *ExitSurveySelectOptionsTable {
+	return &ExitSurveySelectOptionsTable{
+		db,
+	}
+}
+
+func (ExitSurveySelectOptionsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS exit_survey_select_options(
+	"form_input_id" int4 NOT NULL,
+	"options" varchar(100)[] NOT NULL,
+	FOREIGN KEY("form_input_id") REFERENCES form_input("id") ON DELETE CASCADE,
+	PRIMARY KEY("form_input_id")
+);`
+}
+
+// GetAll returns the options for the given form inputs, keyed by form input ID. Inputs answered in a text box are
+// omitted.
+func (t *ExitSurveySelectOptionsTable) GetAll(ctx context.Context, formInputIds []int) (map[int][]string, error) {
+	query := `SELECT "form_input_id", "options" FROM exit_survey_select_options WHERE "form_input_id" = ANY($1);`
+
+	rows, err := t.Query(ctx, query, formInputIds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	options := make(map[int][]string)
+	for rows.Next() {
+		var formInputId int
+		var inputOptions []string
+		if err := rows.Scan(&formInputId, &inputOptions); err != nil {
+			return nil, err
+		}
+
+		options[formInputId] = inputOptions
+	}
+
+	return options, rows.Err()
+}
+
+func (t *ExitSurveySelectOptionsTable) Set(ctx context.Context, formInputId int, options []string) error {
+	query := `
+INSERT INTO exit_survey_select_options("form_input_id", "options")
+VALUES($1, $2)
+ON CONFLICT("form_input_id") DO UPDATE SET "options" = $2;`
+
+	_, err := t.Exec(ctx, query, formInputId, options)
+	return err
+}
+
+func (t *ExitSurveySelectOptionsTable) Delete(ctx context.Context, formInputId int) error {
+	query := `DELETE FROM exit_survey_select_options WHERE "form_input_id" = $1;`
+
+	_, err := t.Exec(ctx, query, formInputId)
+	return err
+}
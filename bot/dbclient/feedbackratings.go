@@ -0,0 +1,149 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// FeedbackRatingsTable stores the score a user gave on the guild's rating scale, and their reason for it. The shared
+// service_ratings table only holds 1-5 stars, so ratings on other scales are also stored there converted to stars,
+// keeping the average rating comparable between guilds.
+type FeedbackRatingsTable struct {
+	*pgxpool.Pool
+}
+
+type FeedbackRating struct {
+	GuildId  uint64
+	TicketId int
+	Scale    RatingScale
+	Score    int
+	Reason   *string
+	RatedAt  time.Time
+}
+
+func newFeedbackRatingsTable(db *pgxpool.Pool) *FeedbackRatingsTable {
+	return &FeedbackRatingsTable{
+		db,
+	}
+}
+
+func (FeedbackRatingsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS feedback_ratings(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"scale" varchar(16) NOT NULL,
+	"score" int2 NOT NULL,
+	"reason" varchar(1024) DEFAULT NULL,
+	"rated_at" timestamptz NOT NULL DEFAULT NOW(),
+	FOREIGN KEY("ticket_id", "guild_id") REFERENCES tickets("id", "guild_id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+CREATE INDEX IF NOT EXISTS feedback_ratings_guild_id_rated_at ON feedback_ratings("guild_id", "rated_at");`
+}
+
+func (t *FeedbackRatingsTable) Get(ctx context.Context, guildId uint64, ticketId int) (FeedbackRating, bool, error) {
+	query := `
+SELECT "guild_id", "ticket_id", "scale", "score", "reason", "rated_at"
+FROM feedback_ratings
+WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	var rating FeedbackRating
+	if err := t.QueryRow(ctx, query, guildId, ticketId).Scan(
+		&rating.GuildId, &rating.TicketId, &rating.Scale, &rating.Score, &rating.Reason, &rating.RatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return FeedbackRating{}, false, nil
+		}
+
+		return FeedbackRating{}, false, err
+	}
+
+	return rating, true, nil
+}
+
+// Set stores the rating, replacing any previous rating for the ticket along with its reason
+func (t *FeedbackRatingsTable) Set(ctx context.Context, rating FeedbackRating) error {
+	query := `
+INSERT INTO feedback_ratings("guild_id", "ticket_id", "scale", "score", "reason", "rated_at")
+VALUES($1, $2, $3, $4, NULL, NOW())
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET "scale" = $3, "score" = $4, "reason" = NULL, "rated_at" = NOW();`
+
+	_, err := t.Exec(ctx, query, rating.GuildId, rating.TicketId, rating.Scale, rating.Score)
+	return err
+}
+
+// SetReason returns false if the ticket has not been rated
+func (t *FeedbackRatingsTable) SetReason(ctx context.Context, guildId uint64, ticketId int, reason string) (bool, error) {
+	query := `UPDATE feedback_ratings SET "reason" = $3 WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	res, err := t.Exec(ctx, query, guildId, ticketId, reason)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// GetScoreCounts returns the number of ratings given with each score on the scale, since the given time
+func (t *FeedbackRatingsTable) GetScoreCounts(ctx context.Context, guildId uint64, scale RatingScale, since time.Time) (map[int]int, error) {
+	query := `
+SELECT "score", COUNT(*)
+FROM feedback_ratings
+WHERE "guild_id" = $1 AND "scale" = $2 AND "rated_at" >= $3
+GROUP BY "score";`
+
+	rows, err := t.Query(ctx, query, guildId, scale, since)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var score, count int
+		if err := rows.Scan(&score, &count); err != nil {
+			return nil, err
+		}
+
+		counts[score] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// GetRecentWithReasons returns the most recent ratings that were given a reason, newest first
+func (t *FeedbackRatingsTable) GetRecentWithReasons(ctx context.Context, guildId uint64, limit int) ([]FeedbackRating, error) {
+	query := `
+SELECT "guild_id", "ticket_id", "scale", "score", "reason", "rated_at"
+FROM feedback_ratings
+WHERE "guild_id" = $1 AND "reason" IS NOT NULL
+ORDER BY "rated_at" DESC
+LIMIT $2;`
+
+	rows, err := t.Query(ctx, query, guildId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ratings []FeedbackRating
+	for rows.Next() {
+		var rating FeedbackRating
+		if err := rows.Scan(
+			&rating.GuildId, &rating.TicketId, &rating.Scale, &rating.Score, &rating.Reason, &rating.RatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		ratings = append(ratings, rating)
+	}
+
+	return ratings, rows.Err()
+}
@@ -0,0 +1,78 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// FeedbackSettingsTable stores how a guild asks users to rate their ticket. Guilds without a row use a 1-5 star scale
+// and never ask for a reason.
+type FeedbackSettingsTable struct {
+	*pgxpool.Pool
+}
+
+type RatingScale string
+
+const (
+	RatingScaleStars  RatingScale = "stars"
+	RatingScaleNps    RatingScale = "nps"
+	RatingScaleThumbs RatingScale = "thumbs"
+)
+
+type FeedbackSettings struct {
+	Scale RatingScale
+	// ReasonThreshold is the highest score for which the user is asked why they gave it, or nil to never ask
+	ReasonThreshold *int
+}
+
+func DefaultFeedbackSettings() FeedbackSettings {
+	return FeedbackSettings{
+		Scale:           RatingScaleStars,
+		ReasonThreshold: nil,
+	}
+}
+
+func newFeedbackSettingsTable(db *pgxpool.Pool) *FeedbackSettingsTable {
+	return &FeedbackSettingsTable{
+		db,
+	}
+}
+
+func (FeedbackSettingsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS feedback_settings(
+	"guild_id" int8 NOT NULL,
+	"scale" varchar(16) NOT NULL,
+	"reason_threshold" int2 DEFAULT NULL,
+	PRIMARY KEY("guild_id")
+);`
+}
+
+// Get returns the guild's settings, or the defaults if it has not configured any
+func (t *FeedbackSettingsTable) Get(ctx context.Context, guildId uint64) (FeedbackSettings, error) {
+	query := `SELECT "scale", "reason_threshold" FROM feedback_settings WHERE "guild_id" = $1;`
+
+	var settings FeedbackSettings
+	if err := t.QueryRow(ctx, query, guildId).Scan(&settings.Scale, &settings.ReasonThreshold); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return DefaultFeedbackSettings(), nil
+		}
+
+		return FeedbackSettings{}, err
+	}
+
+	return settings, nil
+}
+
+func (t *FeedbackSettingsTable) Set(ctx context.Context, guildId uint64, settings FeedbackSettings) error {
+	query := `
+INSERT INTO feedback_settings("guild_id", "scale", "reason_threshold")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id") DO UPDATE SET "scale" = $2, "reason_threshold" = $3;`
+
+	_, err := t.Exec(ctx, query, guildId, settings.Scale, settings.ReasonThreshold)
+	return err
+}
@@ -0,0 +1,95 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// FormInputConditionsTable stores conditions on earlier answers that must be met for a form input to be asked.
+type FormInputConditionsTable struct {
+	*pgxpool.Pool
+}
+
+type FormConditionOperator string
+
+const (
+	FormConditionEquals    FormConditionOperator = "equals"
+	FormConditionNotEquals FormConditionOperator = "not_equals"
+	FormConditionContains  FormConditionOperator = "contains"
+)
+
+type FormInputCondition struct {
+	FormInputId int
+	// DependsOnInputId is the input whose answer the condition is evaluated against, which must come earlier in the form
+	DependsOnInputId int
+	Operator         FormConditionOperator
+	Value            string
+}
+
+func newFormInputConditionsTable(db *pgxpool.Pool) *FormInputConditionsTable {
+	return &FormInputConditionsTable{
+		db,
+	}
+}
+
+func (FormInputConditionsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS form_input_conditions(
+	"form_input_id" int4 NOT NULL,
+	"depends_on_input_id" int4 NOT NULL,
+	"operator" varchar(16) NOT NULL,
+	"value" varchar(255) NOT NULL,
+	FOREIGN KEY("form_input_id") REFERENCES form_input("id") ON DELETE CASCADE,
+	FOREIGN KEY("depends_on_input_id") REFERENCES form_input("id") ON DELETE CASCADE,
+	PRIMARY KEY("form_input_id")
+);
+CREATE INDEX IF NOT EXISTS form_input_conditions_depends_on_input_id ON form_input_conditions("depends_on_input_id");`
+}
+
+// GetAll returns the conditions for the given form inputs, keyed by form input ID. Unconditional inputs are omitted.
+func (t *FormInputConditionsTable) GetAll(ctx context.Context, formInputIds []int) (map[int]FormInputCondition, error) {
+	query := `
+SELECT "form_input_id", "depends_on_input_id", "operator", "value"
+FROM form_input_conditions
+WHERE "form_input_id" = ANY($1);`
+
+	rows, err := t.Query(ctx, query, formInputIds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	conditions := make(map[int]FormInputCondition)
+	for rows.Next() {
+		var condition FormInputCondition
+		if err := rows.Scan(&condition.FormInputId, &condition.DependsOnInputId, &condition.Operator, &condition.Value); err != nil {
+			return nil, err
+		}
+
+		conditions[condition.FormInputId] = condition
+	}
+
+	return conditions, rows.Err()
+}
+
+func (t *FormInputConditionsTable) Set(ctx context.Context, condition FormInputCondition) error {
+	query := `
+INSERT INTO form_input_conditions("form_input_id", "depends_on_input_id", "operator", "value")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("form_input_id") DO UPDATE SET
+	"depends_on_input_id" = $2,
+	"operator" = $3,
+	"value" = $4;`
+
+	_, err := t.Exec(ctx, query, condition.FormInputId, condition.DependsOnInputId, condition.Operator, condition.Value)
+	return err
+}
+
+func (t *FormInputConditionsTable) Delete(ctx context.Context, formInputId int) error {
+	query := `DELETE FROM form_input_conditions WHERE "form_input_id" = $1;`
+
+	_, err := t.Exec(ctx, query, formInputId)
+	return err
+}
@@ -0,0 +1,132 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// FormInputValidationTable stores the rules that answers to a form input must satisfy, beyond the length limits and
+// required flag that are enforced by Discord.
+type FormInputValidationTable struct {
+	*pgxpool.Pool
+}
+
+type FormValidationType string
+
+const (
+	FormValidationText      FormValidationType = "text"
+	FormValidationRegex     FormValidationType = "regex"
+	FormValidationInteger   FormValidationType = "integer"
+	FormValidationDecimal   FormValidationType = "decimal"
+	FormValidationEmail     FormValidationType = "email"
+	FormValidationUrl       FormValidationType = "url"
+	FormValidationSnowflake FormValidationType = "snowflake"
+)
+
+type FormInputValidation struct {
+	FormInputId int
+	Type        FormValidationType
+	// Pattern is only used by FormValidationRegex
+	Pattern   *string
+	MinLength *int
+	MaxLength *int
+	// MinValue and MaxValue are only used by FormValidationInteger and FormValidationDecimal
+	MinValue *float64
+	MaxValue *float64
+}
+
+func newFormInputValidationTable(db *pgxpool.Pool) *FormInputValidationTable {
+	return &FormInputValidationTable{
+		db,
+	}
+}
+
+func (FormInputValidationTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS form_input_validation(
+	"form_input_id" int4 NOT NULL,
+	"type" varchar(16) NOT NULL,
+	"pattern" varchar(255) DEFAULT NULL,
+	"min_length" int4 DEFAULT NULL,
+	"max_length" int4 DEFAULT NULL,
+	"min_value" float8 DEFAULT NULL,
+	"max_value" float8 DEFAULT NULL,
+	FOREIGN KEY("form_input_id") REFERENCES form_input("id") ON DELETE CASCADE,
+	PRIMARY KEY("form_input_id")
+);`
+}
+
+func (t *FormInputValidationTable) Get(ctx context.Context, formInputId int) (FormInputValidation, bool, error) {
+	query := `
+SELECT "form_input_id", "type", "pattern", "min_length", "max_length", "min_value", "max_value"
+FROM form_input_validation
+WHERE "form_input_id" = $1;`
+
+	var rule FormInputValidation
+	if err := t.QueryRow(ctx, query, formInputId).Scan(
+		&rule.FormInputId, &rule.Type, &rule.Pattern, &rule.MinLength, &rule.MaxLength, &rule.MinValue, &rule.MaxValue,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return FormInputValidation{}, false, nil
+		}
+
+		return FormInputValidation{}, false, err
+	}
+
+	return rule, true, nil
+}
+
+// GetAll returns the rules for the given form inputs, keyed by form input ID. Inputs without rules are omitted.
+func (t *FormInputValidationTable) GetAll(ctx context.Context, formInputIds []int) (map[int]FormInputValidation, error) {
+	query := `
+SELECT "form_input_id", "type", "pattern", "min_length", "max_length", "min_value", "max_value"
+FROM form_input_validation
+WHERE "form_input_id" = ANY($1);`
+
+	rows, err := t.Query(ctx, query, formInputIds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rules := make(map[int]FormInputValidation)
+	for rows.Next() {
+		var rule FormInputValidation
+		if err := rows.Scan(
+			&rule.FormInputId, &rule.Type, &rule.Pattern, &rule.MinLength, &rule.MaxLength, &rule.MinValue, &rule.MaxValue,
+		); err != nil {
+			return nil, err
+		}
+
+		rules[rule.FormInputId] = rule
+	}
+
+	return rules, rows.Err()
+}
+
+func (t *FormInputValidationTable) Set(ctx context.Context, rule FormInputValidation) error {
+	query := `
+INSERT INTO form_input_validation("form_input_id", "type", "pattern", "min_length", "max_length", "min_value", "max_value")
+VALUES($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT("form_input_id") DO UPDATE SET
+	"type" = $2,
+	"pattern" = $3,
+	"min_length" = $4,
+	"max_length" = $5,
+	"min_value" = $6,
+	"max_value" = $7;`
+
+	_, err := t.Exec(ctx, query, rule.FormInputId, rule.Type, rule.Pattern, rule.MinLength, rule.MaxLength, rule.MinValue, rule.MaxValue)
+	return err
+}
+
+func (t *FormInputValidationTable) Delete(ctx context.Context, formInputId int) error {
+	query := `DELETE FROM form_input_validation WHERE "form_input_id" = $1;`
+
+	_, err := t.Exec(ctx, query, formInputId)
+	return err
+}
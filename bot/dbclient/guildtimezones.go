@@ -0,0 +1,63 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// GuildTimezonesTable stores the IANA timezone, e.g. Europe/London, that a guild has chosen for times that are written
+// as plain text, rather than as Discord timestamps. Guilds without a row use UTC.
+type GuildTimezonesTable struct {
+	*pgxpool.Pool
+}
+
+func newGuildTimezonesTable(db *pgxpool.Pool) *GuildTimezonesTable {
+	return &GuildTimezonesTable{
+		db,
+	}
+}
+
+func (GuildTimezonesTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS guild_timezones(
+	"guild_id" int8 NOT NULL,
+	"timezone" varchar(64) NOT NULL,
+	PRIMARY KEY("guild_id")
+);`
+}
+
+// Get returns the guild's timezone, or an empty string if it has not set one
+func (t *GuildTimezonesTable) Get(ctx context.Context, guildId uint64) (string, error) {
+	query := `SELECT "timezone" FROM guild_timezones WHERE "guild_id" = $1;`
+
+	var timezone string
+	if err := t.QueryRow(ctx, query, guildId).Scan(&timezone); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return timezone, nil
+}
+
+func (t *GuildTimezonesTable) Set(ctx context.Context, guildId uint64, timezone string) error {
+	query := `
+INSERT INTO guild_timezones("guild_id", "timezone")
+VALUES($1, $2)
+ON CONFLICT("guild_id") DO UPDATE SET "timezone" = $2;`
+
+	_, err := t.Exec(ctx, query, guildId, timezone)
+	return err
+}
+
+func (t *GuildTimezonesTable) Delete(ctx context.Context, guildId uint64) error {
+	query := `DELETE FROM guild_timezones WHERE "guild_id" = $1;`
+
+	_, err := t.Exec(ctx, query, guildId)
+	return err
+}
@@ -0,0 +1,101 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// Local holds the tables owned by the worker itself, rather than by the shared database module. They live in the same
+// database as the shared tables, so may reference them with foreign keys.
+var Local *LocalDatabase
+
+type LocalDatabase struct {
+	pool *pgxpool.Pool
+
+	BlacklistAppealPanel       *BlacklistAppealPanelTable
+	BlacklistAppeals           *BlacklistAppealsTable
+	CommandRestrictions        *CommandRestrictionsTable
+	CustomIntegrationSettings  *CustomIntegrationSettingsTable
+	ExitSurveySelectOptions    *ExitSurveySelectOptionsTable
+	FeedbackRatings            *FeedbackRatingsTable
+	FeedbackSettings           *FeedbackSettingsTable
+	FormInputConditions        *FormInputConditionsTable
+	FormInputValidation        *FormInputValidationTable
+	GuildTimezones             *GuildTimezonesTable
+	OutboundWebhooks           *OutboundWebhooksTable
+	OutboundWebhookDeadLetters *OutboundWebhookDeadLettersTable
+	OutboundWebhookSecrets     *OutboundWebhookSecretsTable
+	PanelAccessIntegrations    *PanelAccessIntegrationsTable
+	TemplatesEnabled           *TemplatesEnabledTable
+	TicketFormAnswers          *TicketFormAnswersTable
+	TicketSubjects             *TicketSubjectsTable
+
+	TicketClaimLookup *TicketClaimLookup
+	TicketSearch      *TicketSearch
+	TicketVolume      *TicketVolume
+}
+
+type localTable interface {
+	Schema() string
+}
+
+func newLocalDatabase(pool *pgxpool.Pool) *LocalDatabase {
+	return &LocalDatabase{
+		pool: pool,
+
+		BlacklistAppealPanel:       newBlacklistAppealPanelTable(pool),
+		BlacklistAppeals:           newBlacklistAppealsTable(pool),
+		CommandRestrictions:        newCommandRestrictionsTable(pool),
+		CustomIntegrationSettings:  newCustomIntegrationSettingsTable(pool),
+		ExitSurveySelectOptions:    newExitSurveySelectOptionsTable(pool),
+		FeedbackRatings:            newFeedbackRatingsTable(pool),
+		FeedbackSettings:           newFeedbackSettingsTable(pool),
+		FormInputConditions:        newFormInputConditionsTable(pool),
+		FormInputValidation:        newFormInputValidationTable(pool),
+		GuildTimezones:             newGuildTimezonesTable(pool),
+		OutboundWebhooks:           newOutboundWebhooksTable(pool),
+		OutboundWebhookDeadLetters: newOutboundWebhookDeadLettersTable(pool),
+		OutboundWebhookSecrets:     newOutboundWebhookSecretsTable(pool),
+		PanelAccessIntegrations:    newPanelAccessIntegrationsTable(pool),
+		TemplatesEnabled:           newTemplatesEnabledTable(pool),
+		TicketFormAnswers:          newTicketFormAnswersTable(pool),
+		TicketSubjects:             newTicketSubjectsTable(pool),
+
+		TicketClaimLookup: newTicketClaimLookup(pool),
+		TicketSearch:      newTicketSearch(pool),
+		TicketVolume:      newTicketVolume(pool),
+	}
+}
+
+// CreateTables creates any tables that do not exist yet. Tables are created in order, so tables must be listed after
+// any table that they reference.
+func (d *LocalDatabase) CreateTables(ctx context.Context) error {
+	tables := []localTable{
+		d.BlacklistAppealPanel,
+		d.BlacklistAppeals,
+		d.CommandRestrictions,
+		d.CustomIntegrationSettings,
+		d.ExitSurveySelectOptions,
+		d.FeedbackRatings,
+		d.FeedbackSettings,
+		d.FormInputConditions,
+		d.FormInputValidation,
+		d.GuildTimezones,
+		d.OutboundWebhooks,
+		d.OutboundWebhookDeadLetters,
+		d.OutboundWebhookSecrets,
+		d.PanelAccessIntegrations,
+		d.TemplatesEnabled,
+		d.TicketFormAnswers,
+		d.TicketSubjects,
+	}
+
+	for _, table := range tables {
+		if _, err := d.pool.Exec(ctx, table.Schema()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package dbclient
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// OutboundWebhookDeadLettersTable records webhook deliveries that failed on every attempt, so that guilds can find
+// out which events their endpoint missed
+type OutboundWebhookDeadLettersTable struct {
+	*pgxpool.Pool
+}
+
+type OutboundWebhookDeadLetter struct {
+	DeliveryId string
+	WebhookId  int
+	GuildId    uint64
+	Event      string
+	Payload    json.RawMessage
+	Attempts   int
+	Error      string
+	FailedAt   time.Time
+}
+
+func newOutboundWebhookDeadLettersTable(db *pgxpool.Pool) *OutboundWebhookDeadLettersTable {
+	return &OutboundWebhookDeadLettersTable{
+		db,
+	}
+}
+
+func (OutboundWebhookDeadLettersTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS outbound_webhook_dead_letters(
+	"delivery_id" varchar(32) NOT NULL,
+	"webhook_id" int4 NOT NULL,
+	"guild_id" int8 NOT NULL,
+	"event" varchar(32) NOT NULL,
+	"payload" jsonb NOT NULL,
+	"attempts" int4 NOT NULL,
+	"error" text NOT NULL,
+	"failed_at" timestamptz NOT NULL,
+	FOREIGN KEY("webhook_id") REFERENCES outbound_webhooks("id") ON DELETE CASCADE,
+	PRIMARY KEY("delivery_id")
+);
+CREATE INDEX IF NOT EXISTS outbound_webhook_dead_letters_guild_id ON outbound_webhook_dead_letters("guild_id");`
+}
+
+func (t *OutboundWebhookDeadLettersTable) Create(ctx context.Context, deadLetter OutboundWebhookDeadLetter) error {
+	query := `
+INSERT INTO outbound_webhook_dead_letters("delivery_id", "webhook_id", "guild_id", "event", "payload", "attempts", "error", "failed_at")
+VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT("delivery_id") DO NOTHING;`
+
+	_, err := t.Exec(ctx, query,
+		deadLetter.DeliveryId, deadLetter.WebhookId, deadLetter.GuildId, deadLetter.Event, string(deadLetter.Payload),
+		deadLetter.Attempts, deadLetter.Error, deadLetter.FailedAt,
+	)
+	return err
+}
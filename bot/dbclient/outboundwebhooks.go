@@ -0,0 +1,106 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// OutboundWebhooksTable stores the URLs that a guild's ticket lifecycle events are sent to
+type OutboundWebhooksTable struct {
+	*pgxpool.Pool
+}
+
+type OutboundWebhook struct {
+	Id      int
+	GuildId uint64
+	Url     string
+	// Events is the list of events the webhook is subscribed to. An empty list subscribes to all events.
+	Events []string
+}
+
+func newOutboundWebhooksTable(db *pgxpool.Pool) *OutboundWebhooksTable {
+	return &OutboundWebhooksTable{
+		db,
+	}
+}
+
+func (OutboundWebhooksTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS outbound_webhooks(
+	"id" SERIAL NOT NULL UNIQUE,
+	"guild_id" int8 NOT NULL,
+	"url" varchar(255) NOT NULL,
+	"events" varchar(32)[] NOT NULL DEFAULT '{}',
+	UNIQUE("guild_id", "url"),
+	PRIMARY KEY("id")
+);
+CREATE INDEX IF NOT EXISTS outbound_webhooks_guild_id ON outbound_webhooks("guild_id");`
+}
+
+func (t *OutboundWebhooksTable) Get(ctx context.Context, id int) (OutboundWebhook, bool, error) {
+	query := `SELECT "id", "guild_id", "url", "events" FROM outbound_webhooks WHERE "id" = $1;`
+
+	var webhook OutboundWebhook
+	if err := t.QueryRow(ctx, query, id).Scan(&webhook.Id, &webhook.GuildId, &webhook.Url, &webhook.Events); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return OutboundWebhook{}, false, nil
+		}
+
+		return OutboundWebhook{}, false, err
+	}
+
+	return webhook, true, nil
+}
+
+func (t *OutboundWebhooksTable) GetByGuild(ctx context.Context, guildId uint64) ([]OutboundWebhook, error) {
+	query := `SELECT "id", "guild_id", "url", "events" FROM outbound_webhooks WHERE "guild_id" = $1 ORDER BY "id";`
+
+	rows, err := t.Query(ctx, query, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var webhooks []OutboundWebhook
+	for rows.Next() {
+		var webhook OutboundWebhook
+		if err := rows.Scan(&webhook.Id, &webhook.GuildId, &webhook.Url, &webhook.Events); err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// Set creates the webhook, or replaces the events of an existing webhook with the same URL
+func (t *OutboundWebhooksTable) Set(ctx context.Context, guildId uint64, url string, events []string) error {
+	query := `
+INSERT INTO outbound_webhooks("guild_id", "url", "events")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "url") DO UPDATE SET "events" = $3;`
+
+	if events == nil {
+		events = []string{}
+	}
+
+	_, err := t.Exec(ctx, query, guildId, url, events)
+	return err
+}
+
+// Delete removes the webhook, returning false if the guild has no webhook with the URL
+func (t *OutboundWebhooksTable) Delete(ctx context.Context, guildId uint64, url string) (bool, error) {
+	query := `DELETE FROM outbound_webhooks WHERE "guild_id" = $1 AND "url" = $2;`
+
+	res, err := t.Exec(ctx, query, guildId, url)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
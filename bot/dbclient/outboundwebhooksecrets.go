@@ -0,0 +1,54 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// OutboundWebhookSecretsTable stores the secret that each guild's outbound webhook payloads are signed with
+type OutboundWebhookSecretsTable struct {
+	*pgxpool.Pool
+}
+
+func newOutboundWebhookSecretsTable(db *pgxpool.Pool) *OutboundWebhookSecretsTable {
+	return &OutboundWebhookSecretsTable{
+		db,
+	}
+}
+
+func (OutboundWebhookSecretsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS outbound_webhook_secrets(
+	"guild_id" int8 NOT NULL,
+	"secret" varchar(64) NOT NULL,
+	PRIMARY KEY("guild_id")
+);`
+}
+
+func (t *OutboundWebhookSecretsTable) Get(ctx context.Context, guildId uint64) (string, bool, error) {
+	query := `SELECT "secret" FROM outbound_webhook_secrets WHERE "guild_id" = $1;`
+
+	var secret string
+	if err := t.QueryRow(ctx, query, guildId).Scan(&secret); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	return secret, true, nil
+}
+
+func (t *OutboundWebhookSecretsTable) Set(ctx context.Context, guildId uint64, secret string) error {
+	query := `
+INSERT INTO outbound_webhook_secrets("guild_id", "secret")
+VALUES($1, $2)
+ON CONFLICT("guild_id") DO UPDATE SET "secret" = $2;`
+
+	_, err := t.Exec(ctx, query, guildId, secret)
+	return err
+}
@@ -0,0 +1,72 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// PanelAccessIntegrationsTable stores the custom integration that is asked whether a user may open a ticket from a
+// panel, after the panel's access control rules have allowed them.
+type PanelAccessIntegrationsTable struct {
+	*pgxpool.Pool
+}
+
+type PanelAccessIntegration struct {
+	PanelId       int
+	IntegrationId int
+	// AllowOnError lets users open tickets when the integration cannot be reached or returns an invalid response
+	AllowOnError bool
+}
+
+func newPanelAccessIntegrationsTable(db *pgxpool.Pool) *PanelAccessIntegrationsTable {
+	return &PanelAccessIntegrationsTable{
+		db,
+	}
+}
+
+func (PanelAccessIntegrationsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS panel_access_integrations(
+	"panel_id" int4 NOT NULL,
+	"integration_id" int4 NOT NULL,
+	"allow_on_error" bool NOT NULL DEFAULT 'f',
+	FOREIGN KEY("panel_id") REFERENCES panels("panel_id") ON DELETE CASCADE,
+	FOREIGN KEY("integration_id") REFERENCES custom_integrations("id") ON DELETE CASCADE,
+	PRIMARY KEY("panel_id")
+);`
+}
+
+func (t *PanelAccessIntegrationsTable) Get(ctx context.Context, panelId int) (*PanelAccessIntegration, error) {
+	query := `SELECT "panel_id", "integration_id", "allow_on_error" FROM panel_access_integrations WHERE "panel_id" = $1;`
+
+	var accessIntegration PanelAccessIntegration
+	if err := t.QueryRow(ctx, query, panelId).Scan(&accessIntegration.PanelId, &accessIntegration.IntegrationId, &accessIntegration.AllowOnError); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &accessIntegration, nil
+}
+
+func (t *PanelAccessIntegrationsTable) Set(ctx context.Context, accessIntegration PanelAccessIntegration) error {
+	query := `
+INSERT INTO panel_access_integrations("panel_id", "integration_id", "allow_on_error")
+VALUES($1, $2, $3)
+ON CONFLICT("panel_id") DO UPDATE SET "integration_id" = $2, "allow_on_error" = $3;`
+
+	_, err := t.Exec(ctx, query, accessIntegration.PanelId, accessIntegration.IntegrationId, accessIntegration.AllowOnError)
+	return err
+}
+
+func (t *PanelAccessIntegrationsTable) Delete(ctx context.Context, panelId int) error {
+	query := `DELETE FROM panel_access_integrations WHERE "panel_id" = $1;`
+
+	_, err := t.Exec(ctx, query, panelId)
+	return err
+}
@@ -0,0 +1,48 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// TemplatesEnabledTable stores the guilds that have opted in to the template syntax of the templating package, in
+// welcome messages, tags and embeds. Other guilds only have %placeholder% substitution, so that existing messages
+// containing {{ are unaffected.
+type TemplatesEnabledTable struct {
+	*pgxpool.Pool
+}
+
+func newTemplatesEnabledTable(db *pgxpool.Pool) *TemplatesEnabledTable {
+	return &TemplatesEnabledTable{
+		db,
+	}
+}
+
+func (TemplatesEnabledTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS templates_enabled(
+	"guild_id" int8 NOT NULL,
+	PRIMARY KEY("guild_id")
+);`
+}
+
+func (t *TemplatesEnabledTable) IsEnabled(ctx context.Context, guildId uint64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM templates_enabled WHERE "guild_id" = $1);`
+
+	var enabled bool
+	err := t.QueryRow(ctx, query, guildId).Scan(&enabled)
+	return enabled, err
+}
+
+func (t *TemplatesEnabledTable) Set(ctx context.Context, guildId uint64, enabled bool) error {
+	var query string
+	if enabled {
+		query = `INSERT INTO templates_enabled("guild_id") VALUES($1) ON CONFLICT("guild_id") DO NOTHING;`
+	} else {
+		query = `DELETE FROM templates_enabled WHERE "guild_id" = $1;`
+	}
+
+	_, err := t.Exec(ctx, query, guildId)
+	return err
+}
@@ -0,0 +1,44 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// TicketClaimLookup looks up the claims of many tickets at once. It reads from the shared ticket_claims table, so has
+// no schema of its own.
+type TicketClaimLookup struct {
+	*pgxpool.Pool
+}
+
+func newTicketClaimLookup(db *pgxpool.Pool) *TicketClaimLookup {
+	return &TicketClaimLookup{
+		db,
+	}
+}
+
+// GetAll returns the user who claimed each of the tickets, keyed by ticket ID. Unclaimed tickets are omitted.
+func (l *TicketClaimLookup) GetAll(ctx context.Context, guildId uint64, ticketIds []int) (map[int]uint64, error) {
+	query := `SELECT "ticket_id", "user_id" FROM ticket_claims WHERE "guild_id" = $1 AND "ticket_id" = ANY($2);`
+
+	rows, err := l.Query(ctx, query, guildId, ticketIds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	claims := make(map[int]uint64)
+	for rows.Next() {
+		var ticketId int
+		var userId uint64
+		if err := rows.Scan(&ticketId, &userId); err != nil {
+			return nil, err
+		}
+
+		claims[ticketId] = userId
+	}
+
+	return claims, rows.Err()
+}
@@ -0,0 +1,84 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// TicketFormAnswersTable stores the answers given to a panel's form when a ticket is opened, so that closed tickets
+// can be searched by them. The question and its position are copied, as the form may be edited or deleted after the
+// ticket is opened.
+type TicketFormAnswersTable struct {
+	*pgxpool.Pool
+}
+
+type TicketFormAnswer struct {
+	FormInputId int
+	Position    int
+	Question    string
+	Answer      string
+}
+
+func newTicketFormAnswersTable(db *pgxpool.Pool) *TicketFormAnswersTable {
+	return &TicketFormAnswersTable{
+		db,
+	}
+}
+
+func (TicketFormAnswersTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS ticket_form_answers(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"form_input_id" int4 NOT NULL,
+	"position" int4 NOT NULL,
+	"question" varchar(255) NOT NULL,
+	"answer" text NOT NULL,
+	FOREIGN KEY("ticket_id", "guild_id") REFERENCES tickets("id", "guild_id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id", "form_input_id")
+);`
+}
+
+func (t *TicketFormAnswersTable) Create(ctx context.Context, guildId uint64, ticketId int, answers []TicketFormAnswer) error {
+	query := `
+INSERT INTO ticket_form_answers("guild_id", "ticket_id", "form_input_id", "position", "question", "answer")
+VALUES($1, $2, $3, $4, $5, $6)
+ON CONFLICT("guild_id", "ticket_id", "form_input_id") DO NOTHING;`
+
+	batch := &pgx.Batch{}
+	for _, answer := range answers {
+		batch.Queue(query, guildId, ticketId, answer.FormInputId, answer.Position, answer.Question, answer.Answer)
+	}
+
+	return t.SendBatch(ctx, batch).Close()
+}
+
+// GetByTicket returns the answers given when the ticket was opened, in the order they appeared in the form
+func (t *TicketFormAnswersTable) GetByTicket(ctx context.Context, guildId uint64, ticketId int) ([]TicketFormAnswer, error) {
+	query := `
+SELECT "form_input_id", "position", "question", "answer"
+FROM ticket_form_answers
+WHERE "guild_id" = $1 AND "ticket_id" = $2
+ORDER BY "position" ASC, "form_input_id" ASC;`
+
+	rows, err := t.Query(ctx, query, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var answers []TicketFormAnswer
+	for rows.Next() {
+		var answer TicketFormAnswer
+		if err := rows.Scan(&answer.FormInputId, &answer.Position, &answer.Question, &answer.Answer); err != nil {
+			return nil, err
+		}
+
+		answers = append(answers, answer)
+	}
+
+	return answers, rows.Err()
+}
@@ -0,0 +1,131 @@
+package dbclient
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// TicketSearch searches a guild's closed tickets by their close reason, subject, panel title and form answers. It
+// reads from both the shared tables and the worker's own tables, so has no schema of its own.
+type TicketSearch struct {
+	*pgxpool.Pool
+}
+
+type TicketSearchOptions struct {
+	Query string
+	// After and Before bound the time that the ticket was opened
+	After  *time.Time
+	Before *time.Time
+	// StaffId matches tickets claimed or closed by the user
+	StaffId *uint64
+	Limit   int
+	Offset  int
+}
+
+type TicketSearchResult struct {
+	TicketId      int
+	UserId        uint64
+	OpenTime      time.Time
+	CloseTime     *time.Time
+	HasTranscript bool
+	PanelTitle    *string
+	Subject       *string
+	CloseReason   *string
+	ClosedBy      *uint64
+	ClaimedBy     *uint64
+}
+
+func newTicketSearch(db *pgxpool.Pool) *TicketSearch {
+	return &TicketSearch{
+		db,
+	}
+}
+
+// Search returns a page of the closed tickets matching the options, most recently closed first, and the total number
+// of matches
+func (s *TicketSearch) Search(ctx context.Context, guildId uint64, options TicketSearchOptions) ([]TicketSearchResult, int, error) {
+	query := `
+SELECT
+	tickets.id,
+	tickets.user_id,
+	tickets.open_time,
+	tickets.close_time,
+	tickets.has_transcript,
+	panels.title,
+	ticket_subjects.subject,
+	close_reason.close_reason,
+	close_reason.closed_by,
+	ticket_claims.user_id,
+	COUNT(*) OVER()
+FROM tickets
+LEFT OUTER JOIN panels
+	ON tickets.panel_id = panels.panel_id
+LEFT OUTER JOIN ticket_subjects
+	ON tickets.guild_id = ticket_subjects.guild_id AND tickets.id = ticket_subjects.ticket_id
+LEFT OUTER JOIN close_reason
+	ON tickets.guild_id = close_reason.guild_id AND tickets.id = close_reason.ticket_id
+LEFT OUTER JOIN ticket_claims
+	ON tickets.guild_id = ticket_claims.guild_id AND tickets.id = ticket_claims.ticket_id
+WHERE
+	tickets.guild_id = $1
+	AND tickets.open = 'f'
+	AND ($2::timestamptz IS NULL OR tickets.open_time >= $2)
+	AND ($3::timestamptz IS NULL OR tickets.open_time < $3)
+	AND ($4::int8 IS NULL OR ticket_claims.user_id = $4 OR close_reason.closed_by = $4)
+	AND (
+		close_reason.close_reason ILIKE $5
+		OR ticket_subjects.subject ILIKE $5
+		OR panels.title ILIKE $5
+		OR EXISTS(
+			SELECT 1
+			FROM ticket_form_answers
+			WHERE ticket_form_answers.guild_id = tickets.guild_id
+				AND ticket_form_answers.ticket_id = tickets.id
+				AND ticket_form_answers.answer ILIKE $5
+		)
+	)
+ORDER BY tickets.close_time DESC NULLS LAST, tickets.id DESC
+LIMIT $6 OFFSET $7;`
+
+	rows, err := s.Query(ctx, query,
+		guildId, options.After, options.Before, options.StaffId, buildContainsPattern(options.Query), options.Limit, options.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	var results []TicketSearchResult
+	var total int
+	for rows.Next() {
+		var result TicketSearchResult
+		if err := rows.Scan(
+			&result.TicketId,
+			&result.UserId,
+			&result.OpenTime,
+			&result.CloseTime,
+			&result.HasTranscript,
+			&result.PanelTitle,
+			&result.Subject,
+			&result.CloseReason,
+			&result.ClosedBy,
+			&result.ClaimedBy,
+			&total,
+		); err != nil {
+			return nil, 0, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, total, rows.Err()
+}
+
+// buildContainsPattern builds an ILIKE pattern matching values that contain the query, escaping any wildcards in it
+func buildContainsPattern(query string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(query)
+	return "%" + escaped + "%"
+}
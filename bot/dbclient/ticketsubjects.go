@@ -0,0 +1,40 @@
+package dbclient
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// TicketSubjectsTable stores the subject given when a ticket is opened without a panel, so that closed tickets can be
+// searched by it. The subject is otherwise only kept in the channel topic.
+type TicketSubjectsTable struct {
+	*pgxpool.Pool
+}
+
+func newTicketSubjectsTable(db *pgxpool.Pool) *TicketSubjectsTable {
+	return &TicketSubjectsTable{
+		db,
+	}
+}
+
+func (TicketSubjectsTable) Schema() string {
+	return `
+CREATE TABLE IF NOT EXISTS ticket_subjects(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"subject" varchar(256) NOT NULL,
+	FOREIGN KEY("ticket_id", "guild_id") REFERENCES tickets("id", "guild_id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);`
+}
+
+func (t *TicketSubjectsTable) Set(ctx context.Context, guildId uint64, ticketId int, subject string) error {
+	query := `
+INSERT INTO ticket_subjects("guild_id", "ticket_id", "subject")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET "subject" = $3;`
+
+	_, err := t.Exec(ctx, query, guildId, ticketId, subject)
+	return err
+}
@@ -0,0 +1,87 @@
+package dbclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// TicketVolume counts the tickets opened in a guild on each day, where days start at midnight in the guild's timezone.
+// It reads from the shared tickets table, so has no schema of its own. The analytics database only counts days in UTC,
+// so this is only used for guilds that have chosen another timezone.
+type TicketVolume struct {
+	*pgxpool.Pool
+}
+
+type TicketVolumeDay struct {
+	// Date is midnight at the start of the day, in the location that was queried
+	Date  time.Time
+	Count uint64
+}
+
+func newTicketVolume(db *pgxpool.Pool) *TicketVolume {
+	return &TicketVolume{
+		db,
+	}
+}
+
+// IsKnownTimezone returns whether Postgres recognises the timezone name. Go and Postgres may ship different versions
+// of the timezone database, so a name that Go accepts may not be usable with AT TIME ZONE.
+func (v *TicketVolume) IsKnownTimezone(ctx context.Context, name string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM pg_timezone_names WHERE name = $1);`
+
+	var known bool
+	if err := v.QueryRow(ctx, query, name).Scan(&known); err != nil {
+		return false, err
+	}
+
+	return known, nil
+}
+
+// GetLastNDays returns the number of tickets opened on each of the last n days, including today, most recent first.
+// Days without any tickets are included with a count of 0.
+func (v *TicketVolume) GetLastNDays(ctx context.Context, guildId uint64, n int, location *time.Location) ([]TicketVolumeDay, error) {
+	now := time.Now().In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	start := today.AddDate(0, 0, -(n - 1))
+
+	query := `
+SELECT (open_time AT TIME ZONE $2)::date AS day, COUNT(*)
+FROM tickets
+WHERE guild_id = $1 AND open_time >= $3
+GROUP BY day;`
+
+	rows, err := v.Query(ctx, query, guildId, location.String(), start)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	counts := make(map[string]uint64)
+	for rows.Next() {
+		var day time.Time
+		var count uint64
+		if err := rows.Scan(&day, &count); err != nil {
+			return nil, err
+		}
+
+		counts[day.Format(time.DateOnly)] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	days := make([]TicketVolumeDay, n)
+	for i := range days {
+		date := today.AddDate(0, 0, -i)
+		days[i] = TicketVolumeDay{
+			Date:  date,
+			Count: counts[date.Format(time.DateOnly)],
+		}
+	}
+
+	return days, nil
+}
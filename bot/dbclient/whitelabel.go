@@ -0,0 +1,35 @@
+package dbclient
+
+import (
+	"context"
+)
+
+type WhitelabelBot struct {
+	BotId uint64
+	Token string
+}
+
+// GetWhitelabelBots lists every whitelabel bot. The shared database module only supports looking bots up one at a
+// time, so we read the shared whitelabel table directly.
+func (d *LocalDatabase) GetWhitelabelBots(ctx context.Context) ([]WhitelabelBot, error) {
+	query := `SELECT "bot_id", "token" FROM whitelabel;`
+
+	rows, err := d.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var bots []WhitelabelBot
+	for rows.Next() {
+		var bot WhitelabelBot
+		if err := rows.Scan(&bot.BotId, &bot.Token); err != nil {
+			return nil, err
+		}
+
+		bots = append(bots, bot)
+	}
+
+	return bots, rows.Err()
+}
@@ -0,0 +1,149 @@
+package integrations
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+)
+
+// accessDecisionTtl is how long a decision is reused for the same user, panel and form answers, so that a user
+// retrying after a failure elsewhere in the open flow does not call the integration again
+const accessDecisionTtl = time.Second * 30
+
+var ErrInvalidAccessDecision = errors.New("Integration returned an invalid access decision")
+
+type accessCheckBody struct {
+	GuildId  uint64      `json:"guild_id,string"`
+	UserId   uint64      `json:"user_id,string"`
+	PanelId  int         `json:"panel_id"`
+	Timezone string      `json:"timezone"`
+	FormData formAnswers `json:"form_data,omitempty"`
+}
+
+// AccessDecision is the response expected from an access check integration. Message is shown to the user when denied.
+type AccessDecision struct {
+	Allow   *bool  `json:"allow"`
+	Message string `json:"message"`
+}
+
+func (d AccessDecision) Allowed() bool {
+	return d.Allow != nil && *d.Allow
+}
+
+// CheckAccess asks the integration whether the user may open a ticket from the panel. The integration is always sent
+// a POST request, regardless of its configured method.
+func CheckAccess(
+	ctx context.Context,
+	integration database.CustomIntegration,
+	settings dbclient.CustomIntegrationSettings,
+	guildId, userId uint64,
+	panelId int,
+	secrets []database.SecretWithValue,
+	headers []database.CustomIntegrationHeader,
+	formAnswers formAnswers,
+	location *time.Location, // The guild's timezone
+) (AccessDecision, error) {
+	prometheus.LogIntegrationRequest(integration, guildId)
+
+	body := accessCheckBody{
+		GuildId:  guildId,
+		UserId:   userId,
+		PanelId:  panelId,
+		Timezone: location.String(),
+	}
+
+	if !integration.Public {
+		body.FormData = formAnswers
+	}
+
+	cacheKey, err := hashAccessCheck(body)
+	if err != nil {
+		return AccessDecision{}, err
+	}
+
+	cached, ok, err := redis.GetIntegrationResponse(ctx, guildId, integration.Id, cacheKey)
+	if err != nil {
+		return AccessDecision{}, err
+	}
+
+	if ok {
+		prometheus.LogIntegrationCacheHit(integration)
+		return parseAccessDecision(cached)
+	}
+
+	url := render(integration.WebhookUrl, guildId, userId, secrets)
+	headerMap := renderHeaders(headers, guildId, userId, secrets)
+
+	res, err := doRequest(ctx, integration, settings, http.MethodPost, url, headerMap, body)
+	if err != nil {
+		return AccessDecision{}, err
+	}
+
+	decision, err := parseAccessDecision(res)
+	if err != nil {
+		if err := recordFailure(ctx, integration, settings, "invalid_response"); err != nil {
+			return AccessDecision{}, err
+		}
+
+		return AccessDecision{}, err
+	}
+
+	if err := redis.RecordIntegrationSuccess(ctx, guildId, integration.Id); err != nil {
+		return AccessDecision{}, err
+	}
+
+	if err := redis.StoreIntegrationResponse(ctx, guildId, integration.Id, cacheKey, res, accessDecisionTtl); err != nil {
+		return AccessDecision{}, err
+	}
+
+	return decision, nil
+}
+
+func parseAccessDecision(res []byte) (AccessDecision, error) {
+	var decision AccessDecision
+	if err := json.NewDecoder(bytes.NewBuffer(res)).Decode(&decision); err != nil {
+		return AccessDecision{}, err
+	}
+
+	if decision.Allow == nil {
+		return AccessDecision{}, ErrInvalidAccessDecision
+	}
+
+	return decision, nil
+}
+
+// hashAccessCheck builds the cache key for a decision. Form answers are included, as the integration may use them.
+func hashAccessCheck(body accessCheckBody) (string, error) {
+	labels := make([]string, 0, len(body.FormData))
+	for label := range body.FormData {
+		labels = append(labels, label)
+	}
+
+	sort.Strings(labels)
+
+	hash := sha256.New()
+	hash.Write([]byte("access:" + strconv.FormatUint(body.UserId, 10) + ":" + strconv.Itoa(body.PanelId)))
+
+	for _, label := range labels {
+		encoded, err := json.Marshal([]any{label, body.FormData[label]})
+		if err != nil {
+			return "", err
+		}
+
+		hash.Write(encoded)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,46 @@
+package integrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/integrations/bloxlink"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations/linkedaccounts"
+)
+
+// bloxlinkProvider provides the roblox_* placeholders
+type bloxlinkProvider struct {
+	integration *bloxlink.BloxlinkIntegration
+}
+
+var _ linkedaccounts.Provider = (*bloxlinkProvider)(nil)
+
+func (p *bloxlinkProvider) Name() string {
+	return "bloxlink"
+}
+
+func (p *bloxlinkProvider) Placeholders() []string {
+	return []string{"roblox_username", "roblox_id", "roblox_display_name", "roblox_profile_url", "roblox_account_age", "roblox_account_created"}
+}
+
+func (p *bloxlinkProvider) Lookup(ctx context.Context, userId uint64) (map[string]string, error) {
+	user, err := p.integration.GetRobloxUser(ctx, userId)
+	if err != nil {
+		if errors.Is(err, bloxlink.ErrUserNotFound) {
+			return nil, linkedaccounts.ErrNotLinked
+		}
+
+		return nil, err
+	}
+
+	return map[string]string{
+		"roblox_username":        user.Name,
+		"roblox_id":              strconv.Itoa(user.Id),
+		"roblox_display_name":    user.DisplayName,
+		"roblox_profile_url":     fmt.Sprintf("https://www.roblox.com/users/%d/profile", user.Id),
+		"roblox_account_age":     fmt.Sprintf("<t:%d:R>", user.Created.Unix()),
+		"roblox_account_created": fmt.Sprintf("<t:%d:D>", user.Created.Unix()),
+	}, nil
+}
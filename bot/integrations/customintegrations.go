@@ -3,15 +3,20 @@ package integrations
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations/jsonpath"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/config"
 )
@@ -25,6 +30,7 @@ var (
 	}
 
 	ErrIntegrationReturnedErrorStatus = errors.New("Integration returned an error status")
+	ErrCircuitOpen                    = errors.New("Integration is failing and has been temporarily disabled")
 )
 
 type formAnswers map[string]*string
@@ -35,42 +41,41 @@ type integrationWebhookBody struct {
 	TicketId        int         `json:"ticket_id"`
 	TicketChannelId *uint64     `json:"ticket_channel_id,string"`
 	IsNewTicket     bool        `json:"is_new_ticket"`
+	Timezone        string      `json:"timezone"`
 	FormData        formAnswers `json:"form_data,omitempty"`
 }
 
 func Fetch(
 	ctx context.Context,
 	integration database.CustomIntegration,
+	settings dbclient.CustomIntegrationSettings,
 	ticket database.Ticket,
 	secrets []database.SecretWithValue,
 	headers []database.CustomIntegrationHeader,
 	placeholders []database.CustomIntegrationPlaceholder, // Only include placeholders that are actually used
 	formAnswers formAnswers,
+	location *time.Location, // The guild's timezone, sent in POST bodies
 ) (map[string]string, error) {
-	prometheus.LogIntegrationRequest(integration, ticket.GuildId)
-
-	url := strings.ReplaceAll(integration.WebhookUrl, "%user_id%", strconv.FormatUint(ticket.UserId, 10))
-	url = strings.ReplaceAll(url, "%guild_id%", strconv.FormatUint(ticket.GuildId, 10))
-	for _, secret := range secrets {
-		url = strings.ReplaceAll(url, "%"+secret.Name+"%", secret.Value)
-	}
-
-	// Apply headers
-	headerMap := make(map[string]string)
-	for _, header := range headers {
-		if isHeaderBlacklisted(header.Name) {
-			continue
-		}
+	parsed, _, err := FetchWithResponse(ctx, integration, settings, ticket, secrets, headers, placeholders, formAnswers, location)
+	return parsed, err
+}
 
-		value := header.Value
-		value = strings.ReplaceAll(value, "%user_id%", strconv.FormatUint(ticket.UserId, 10))
-		value = strings.ReplaceAll(value, "%guild_id%", strconv.FormatUint(ticket.GuildId, 10))
-		for _, secret := range secrets {
-			value = strings.ReplaceAll(value, "%"+secret.Name+"%", secret.Value)
-		}
+// FetchWithResponse is Fetch, also returning the raw response body, which may have come from the cache
+func FetchWithResponse(
+	ctx context.Context,
+	integration database.CustomIntegration,
+	settings dbclient.CustomIntegrationSettings,
+	ticket database.Ticket,
+	secrets []database.SecretWithValue,
+	headers []database.CustomIntegrationHeader,
+	placeholders []database.CustomIntegrationPlaceholder,
+	formAnswers formAnswers,
+	location *time.Location,
+) (map[string]string, []byte, error) {
+	prometheus.LogIntegrationRequest(integration, ticket.GuildId)
 
-		headerMap[header.Name] = value
-	}
+	url := render(integration.WebhookUrl, ticket.GuildId, ticket.UserId, secrets)
+	headerMap := renderHeaders(headers, ticket.GuildId, ticket.UserId, secrets)
 
 	var body requestBody = nil
 	if integration.HttpMethod == http.MethodPost {
@@ -80,6 +85,7 @@ func Fetch(
 			TicketId:        ticket.Id,
 			TicketChannelId: ticket.ChannelId,
 			IsNewTicket:     true,
+			Timezone:        location.String(),
 		}
 
 		if !integration.Public {
@@ -89,15 +95,145 @@ func Fetch(
 		body = postBody
 	}
 
-	res, err := SecureProxy.DoRequest(ctx, integration.HttpMethod, url, headerMap, body)
+	// POST bodies contain the ticket and form answers, so only GET responses can be shared between tickets
+	cacheable := settings.CacheTtl > 0 && integration.HttpMethod == http.MethodGet
+	urlHash := hashUrl(integration.HttpMethod, url)
+
+	if cacheable {
+		cached, ok, err := redis.GetIntegrationResponse(ctx, settings.GuildId, integration.Id, urlHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if ok {
+			prometheus.LogIntegrationCacheHit(integration)
+			parsed, err := parseResponse(cached, placeholders)
+			return parsed, cached, err
+		}
+	}
+
+	res, err := doRequest(ctx, integration, settings, integration.HttpMethod, url, headerMap, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := parseResponse(res, placeholders)
+	if err != nil {
+		if err := recordFailure(ctx, integration, settings, "invalid_response"); err != nil {
+			return nil, res, err
+		}
+
+		return nil, res, err
+	}
+
+	if err := redis.RecordIntegrationSuccess(ctx, settings.GuildId, integration.Id); err != nil {
+		return nil, res, err
+	}
+
+	if cacheable {
+		if err := redis.StoreIntegrationResponse(ctx, settings.GuildId, integration.Id, urlHash, res, settings.CacheTtl); err != nil {
+			return nil, res, err
+		}
+	}
+
+	return parsed, res, nil
+}
+
+// doRequest sends a request to the integration through the secure proxy, unless its circuit breaker is open. Failures
+// to get a response are counted towards the circuit breaker, but the caller must record whether the response was valid.
+func doRequest(
+	ctx context.Context,
+	integration database.CustomIntegration,
+	settings dbclient.CustomIntegrationSettings,
+	method, url string,
+	headers map[string]string,
+	body requestBody,
+) ([]byte, error) {
+	circuitOpen, err := redis.IsIntegrationCircuitOpen(ctx, settings.GuildId, integration.Id)
 	if err != nil {
 		return nil, err
 	}
 
+	if circuitOpen {
+		prometheus.LogIntegrationError(integration, "circuit_open")
+		return nil, ErrCircuitOpen
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, settings.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	res, err := SecureProxy.DoRequest(requestCtx, method, url, headers, body)
+	prometheus.LogIntegrationResponse(integration, time.Since(start))
+
+	if err != nil {
+		// The proxy does not wrap transport errors, so check whether our own deadline was hit
+		reason := "error"
+		if errors.Is(requestCtx.Err(), context.DeadlineExceeded) {
+			reason = "timeout"
+		}
+
+		if err := recordFailure(ctx, integration, settings, reason); err != nil {
+			return nil, err
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// render substitutes the user ID, guild ID and secrets into a URL or header value
+func render(s string, guildId, userId uint64, secrets []database.SecretWithValue) string {
+	s = strings.ReplaceAll(s, "%user_id%", strconv.FormatUint(userId, 10))
+	s = strings.ReplaceAll(s, "%guild_id%", strconv.FormatUint(guildId, 10))
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, "%"+secret.Name+"%", secret.Value)
+	}
+
+	return s
+}
+
+func renderHeaders(headers []database.CustomIntegrationHeader, guildId, userId uint64, secrets []database.SecretWithValue) map[string]string {
+	headerMap := make(map[string]string)
+	for _, header := range headers {
+		if isHeaderBlacklisted(header.Name) {
+			continue
+		}
+
+		headerMap[header.Name] = render(header.Value, guildId, userId, secrets)
+	}
+
+	return headerMap
+}
+
+func recordFailure(ctx context.Context, integration database.CustomIntegration, settings dbclient.CustomIntegrationSettings, reason string) error {
+	prometheus.LogIntegrationError(integration, reason)
+
+	opened, err := redis.RecordIntegrationFailure(ctx, settings.GuildId, integration.Id, settings.FailureThreshold, settings.FailureWindow, settings.OpenDuration)
+	if err != nil {
+		return err
+	}
+
+	if opened {
+		prometheus.LogIntegrationCircuitOpened(integration)
+	}
+
+	return nil
+}
+
+// hashUrl is used as the cache key, as the rendered URL may contain secrets
+func hashUrl(method, url string) string {
+	hash := sha256.Sum256([]byte(method + " " + url))
+	return hex.EncodeToString(hash[:])
+}
+
+func parseResponse(res []byte, placeholders []database.CustomIntegrationPlaceholder) (map[string]string, error) {
 	decoder := json.NewDecoder(bytes.NewBuffer(res))
 	decoder.UseNumber()
 
-	var jsonBody map[string]any
+	// The top level of the response may be an array or a scalar, as well as an object
+	var jsonBody any
 	if err := decoder.Decode(&jsonBody); err != nil {
 		return nil, err
 	}
@@ -105,34 +241,19 @@ func Fetch(
 	return parseBody(jsonBody, placeholders), nil
 }
 
-func parseBody(body map[string]any, placeholders []database.CustomIntegrationPlaceholder) map[string]string {
+func parseBody(body any, placeholders []database.CustomIntegrationPlaceholder) map[string]string {
 	parsed := make(map[string]string)
 
-outer:
 	for _, placeholder := range placeholders {
 		parsed[placeholder.Name] = "N/A"
 
-		current := body
-		split := strings.Split(placeholder.JsonPath, ".")
-		for i, key := range split {
-			if i == len(split)-1 {
-				value, ok := current[key]
-				if ok {
-					parsed[placeholder.Name] = fmt.Sprintf("%v", value)
-				}
-			} else {
-				nested, ok := current[key]
-				if !ok {
-					continue outer
-				}
-
-				nestedMap, ok := nested.(map[string]any)
-				if current == nil {
-					continue outer
-				}
-
-				current = nestedMap
-			}
+		path, err := jsonpath.Parse(placeholder.JsonPath)
+		if err != nil {
+			continue
+		}
+
+		if value, ok := path.Extract(body); ok {
+			parsed[placeholder.Name] = value
 		}
 	}
 
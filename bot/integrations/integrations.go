@@ -1,20 +1,66 @@
 package integrations
 
 import (
+	"fmt"
+	"net/http"
+	"time"
+
 	"github.com/jadevelopmentgrp/Tickets-Utilities/integrations/bloxlink"
 	"github.com/jadevelopmentgrp/Tickets-Utilities/webproxy"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations/linkedaccounts"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/jadevelopmentgrp/Tickets-Worker/config"
 )
 
 var (
-	WebProxy    *webproxy.WebProxy
-	SecureProxy *SecureProxyClient
-	Bloxlink    *bloxlink.BloxlinkIntegration
+	WebProxy       *webproxy.WebProxy
+	SecureProxy    *SecureProxyClient
+	Bloxlink       *bloxlink.BloxlinkIntegration
+	LinkedAccounts *linkedaccounts.Registry
 )
 
-func InitIntegrations() {
+func InitIntegrations() error {
 	WebProxy = webproxy.NewWebProxy(config.Conf.WebProxy.Url, config.Conf.WebProxy.AuthHeaderName, config.Conf.WebProxy.AuthHeaderValue)
 	Bloxlink = bloxlink.NewBloxlinkIntegration(redis.Client, WebProxy, config.Conf.Integrations.BloxlinkApiKey)
 	SecureProxy = NewSecureProxy(config.Conf.Integrations.SecureProxyUrl)
+
+	registry, err := newLinkedAccountRegistry()
+	if err != nil {
+		return err
+	}
+
+	LinkedAccounts = registry
+	return nil
+}
+
+// newLinkedAccountRegistry registers the enabled linked account providers, in the configured order
+func newLinkedAccountRegistry() (*linkedaccounts.Registry, error) {
+	httpProviders, err := linkedaccounts.ParseHttpProviders(&http.Client{Timeout: time.Second * 5}, config.Conf.Integrations.LinkedAccountHttpProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	available := map[string]linkedaccounts.Provider{
+		"bloxlink": &bloxlinkProvider{Bloxlink},
+	}
+
+	for _, provider := range httpProviders {
+		if _, ok := available[provider.Name()]; ok {
+			return nil, fmt.Errorf("linked account provider %s is defined more than once", provider.Name())
+		}
+
+		available[provider.Name()] = provider
+	}
+
+	providers := make([]linkedaccounts.Provider, 0, len(config.Conf.Integrations.LinkedAccountProviders))
+	for _, name := range config.Conf.Integrations.LinkedAccountProviders {
+		provider, ok := available[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown linked account provider %s", name)
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return linkedaccounts.NewRegistry(providers...)
 }
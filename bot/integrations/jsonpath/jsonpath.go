@@ -0,0 +1,549 @@
+// Package jsonpath extracts values from custom integration responses for use as placeholders.
+//
+// A path is a series of segments, optionally starting with $:
+//
+//	user.name               keys, separated by dots
+//	['key.with.dots']       keys containing special characters, in quotes
+//	roles[0], roles[-1]     array indexes, counting from the end if negative
+//	roles[*].name, user.*   wildcards, matching every element of an array or value of an object
+//	roles[?(@.primary)]     filters, matching elements where the relative path is truthy
+//	roles[?(@.type == 'x')] filters comparing the relative path to a literal, using == or !=
+//
+// and may be followed by modifiers, separated by pipes:
+//
+//	roles[*].name | join(' / ') | default('None')
+//
+// Multiple matches are joined with ", " unless a join modifier is given. The default modifier is used when nothing
+// matches.
+package jsonpath
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultSeparator = ", "
+
+type Path struct {
+	segments  []segment
+	separator string
+	def       *string
+}
+
+type segment interface {
+	apply(value any) []any
+}
+
+type (
+	keySegment      struct{ key string }
+	indexSegment    struct{ index int }
+	wildcardSegment struct{}
+	filterSegment   struct {
+		path     []segment
+		operator string // empty if the filter only checks that the path is truthy
+		literal  string
+	}
+)
+
+// Parse parses a path, returning an error describing the position of any syntax error
+func Parse(expr string) (*Path, error) {
+	p := &parser{expr: expr}
+
+	segments, err := p.parsePath(false)
+	if err != nil {
+		return nil, err
+	}
+
+	path := &Path{
+		segments:  segments,
+		separator: defaultSeparator,
+	}
+
+	for {
+		p.skipSpaces()
+		if p.done() {
+			break
+		}
+
+		if p.peek() != '|' {
+			return nil, p.errorf("expected |")
+		}
+
+		p.pos++
+		if err := p.parseModifier(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return path, nil
+}
+
+// Extract evaluates the path against a decoded JSON body, returning false if nothing matched and there is no default
+func (p *Path) Extract(body any) (string, bool) {
+	values := []any{body}
+	for _, segment := range p.segments {
+		var next []any
+		for _, value := range values {
+			next = append(next, segment.apply(value)...)
+		}
+
+		values = next
+	}
+
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		if str, ok := format(value); ok {
+			formatted = append(formatted, str)
+		}
+	}
+
+	if len(formatted) == 0 {
+		if p.def != nil {
+			return *p.def, true
+		}
+
+		return "", false
+	}
+
+	return strings.Join(formatted, p.separator), true
+}
+
+func (s keySegment) apply(value any) []any {
+	if object, ok := value.(map[string]any); ok {
+		if child, ok := object[s.key]; ok {
+			return []any{child}
+		}
+	}
+
+	return nil
+}
+
+func (s indexSegment) apply(value any) []any {
+	array, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+
+	index := s.index
+	if index < 0 {
+		index += len(array)
+	}
+
+	if index < 0 || index >= len(array) {
+		return nil
+	}
+
+	return []any{array[index]}
+}
+
+func (wildcardSegment) apply(value any) []any {
+	switch v := value.(type) {
+	case []any:
+		return v
+	case map[string]any:
+		// Map iteration order is random, so sort by key to keep placeholders stable between requests
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		values := make([]any, len(keys))
+		for i, key := range keys {
+			values[i] = v[key]
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
+
+func (s filterSegment) apply(value any) []any {
+	candidates, ok := value.([]any)
+	if !ok {
+		candidates = []any{value}
+	}
+
+	var matched []any
+	for _, candidate := range candidates {
+		if s.matches(candidate) {
+			matched = append(matched, candidate)
+		}
+	}
+
+	return matched
+}
+
+func (s filterSegment) matches(value any) bool {
+	values := []any{value}
+	for _, segment := range s.path {
+		var next []any
+		for _, value := range values {
+			next = append(next, segment.apply(value)...)
+		}
+
+		values = next
+	}
+
+	if len(values) == 0 {
+		return s.operator == "!="
+	}
+
+	switch s.operator {
+	case "==":
+		return equals(values[0], s.literal)
+	case "!=":
+		return !equals(values[0], s.literal)
+	default:
+		return truthy(values[0])
+	}
+}
+
+func equals(value any, literal string) bool {
+	str, ok := format(value)
+	if !ok {
+		return literal == "null"
+	}
+
+	if str == literal {
+		return true
+	}
+
+	// Compare numbers by value, so that 1 matches 1.0
+	if _, isString := value.(string); !isString {
+		a, errA := strconv.ParseFloat(str, 64)
+		b, errB := strconv.ParseFloat(literal, 64)
+		return errA == nil && errB == nil && a == b
+	}
+
+	return false
+}
+
+func truthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case json.Number:
+		f, err := v.Float64()
+		return err != nil || f != 0
+	case float64:
+		return v != 0
+	default:
+		return true
+	}
+}
+
+// format converts a value to placeholder text. Objects and arrays are encoded as JSON. Null is treated as missing.
+func format(value any) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+
+		return string(encoded), true
+	}
+}
+
+type parser struct {
+	expr string
+	pos  int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.expr)
+}
+
+func (p *parser) peek() byte {
+	return p.expr[p.pos]
+}
+
+func (p *parser) skipSpaces() {
+	for !p.done() && p.peek() == ' ' {
+		p.pos++
+	}
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid path at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// parsePath parses segments until the end of the path. Relative paths inside filters also stop at spaces, operators
+// and the closing parenthesis.
+func (p *parser) parsePath(relative bool) ([]segment, error) {
+	p.skipSpaces()
+
+	if relative {
+		if p.done() || p.peek() != '@' {
+			return nil, p.errorf("expected @")
+		}
+
+		p.pos++
+	} else if !p.done() && p.peek() == '$' {
+		p.pos++
+	}
+
+	var segments []segment
+
+	// Paths may start with a key without a leading dot, e.g. user.name
+	if !p.done() && !p.isPathEnd(relative) && p.peek() != '.' && p.peek() != '[' {
+		key := p.readKey(relative)
+		if key == "" {
+			return nil, p.errorf("expected key")
+		}
+
+		segments = append(segments, keySegment{key})
+	}
+
+	for !p.done() && !p.isPathEnd(relative) {
+		switch p.peek() {
+		case '.':
+			p.pos++
+			if !p.done() && p.peek() == '*' {
+				p.pos++
+				segments = append(segments, wildcardSegment{})
+				continue
+			}
+
+			key := p.readKey(relative)
+			if key == "" {
+				return nil, p.errorf("expected key")
+			}
+
+			segments = append(segments, keySegment{key})
+		case '[':
+			p.pos++
+			segment, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+
+			segments = append(segments, segment)
+		case ' ':
+			// Only spaces before a modifier may follow a bracket
+			p.skipSpaces()
+			if !p.done() && p.peek() != '|' {
+				return nil, p.errorf("expected |")
+			}
+		default:
+			return nil, p.errorf("unexpected %q", p.peek())
+		}
+	}
+
+	return segments, nil
+}
+
+// isPathEnd returns whether the path ends at the current character. Keys in top level paths may contain spaces, for
+// compatibility with paths written before brackets were supported.
+func (p *parser) isPathEnd(relative bool) bool {
+	switch p.peek() {
+	case '|':
+		return true
+	case ' ', '=', '!', ')':
+		return relative
+	default:
+		return false
+	}
+}
+
+func (p *parser) readKey(relative bool) string {
+	start := p.pos
+	for !p.done() && !p.isPathEnd(relative) && p.peek() != '.' && p.peek() != '[' {
+		p.pos++
+	}
+
+	// Spaces before a modifier are not part of the key
+	return strings.TrimRight(p.expr[start:p.pos], " ")
+}
+
+// parseBracket parses the contents of [...], after the opening bracket
+func (p *parser) parseBracket() (segment, error) {
+	p.skipSpaces()
+	if p.done() {
+		return nil, p.errorf("unterminated [")
+	}
+
+	var segment segment
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		key, err := p.readString()
+		if err != nil {
+			return nil, err
+		}
+
+		segment = keySegment{key}
+	case c == '*':
+		p.pos++
+		segment = wildcardSegment{}
+	case c == '?':
+		filter, err := p.parseFilter()
+		if err != nil {
+			return nil, err
+		}
+
+		segment = filter
+	default:
+		start := p.pos
+		for !p.done() && (p.peek() == '-' || (p.peek() >= '0' && p.peek() <= '9')) {
+			p.pos++
+		}
+
+		index, err := strconv.Atoi(p.expr[start:p.pos])
+		if err != nil {
+			p.pos = start
+			return nil, p.errorf("expected index, quoted key, * or filter")
+		}
+
+		segment = indexSegment{index}
+	}
+
+	p.skipSpaces()
+	if p.done() || p.peek() != ']' {
+		return nil, p.errorf("expected ]")
+	}
+
+	p.pos++
+	return segment, nil
+}
+
+// parseFilter parses ?(@.path), ?(@.path == literal) or ?(@.path != literal)
+func (p *parser) parseFilter() (filterSegment, error) {
+	p.pos++ // ?
+	if p.done() || p.peek() != '(' {
+		return filterSegment{}, p.errorf("expected (")
+	}
+
+	p.pos++
+
+	path, err := p.parsePath(true)
+	if err != nil {
+		return filterSegment{}, err
+	}
+
+	filter := filterSegment{path: path}
+
+	p.skipSpaces()
+	if strings.HasPrefix(p.expr[p.pos:], "==") || strings.HasPrefix(p.expr[p.pos:], "!=") {
+		filter.operator = p.expr[p.pos : p.pos+2]
+		p.pos += 2
+		p.skipSpaces()
+
+		if p.done() {
+			return filterSegment{}, p.errorf("expected literal")
+		}
+
+		if c := p.peek(); c == '\'' || c == '"' {
+			if filter.literal, err = p.readString(); err != nil {
+				return filterSegment{}, err
+			}
+		} else {
+			start := p.pos
+			for !p.done() && p.peek() != ')' && p.peek() != ' ' {
+				p.pos++
+			}
+
+			filter.literal = p.expr[start:p.pos]
+		}
+
+		p.skipSpaces()
+	}
+
+	if p.done() || p.peek() != ')' {
+		return filterSegment{}, p.errorf("expected )")
+	}
+
+	p.pos++
+	return filter, nil
+}
+
+// readString reads a string in single or double quotes. Backslashes escape the next character.
+func (p *parser) readString() (string, error) {
+	quote := p.peek()
+	p.pos++
+
+	var sb strings.Builder
+	for !p.done() {
+		c := p.peek()
+		p.pos++
+
+		switch {
+		case c == '\\' && !p.done():
+			sb.WriteByte(p.peek())
+			p.pos++
+		case c == quote:
+			return sb.String(), nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", errors.New("invalid path: unterminated string")
+}
+
+func (p *parser) parseModifier(path *Path) error {
+	p.skipSpaces()
+
+	start := p.pos
+	for !p.done() && p.peek() >= 'a' && p.peek() <= 'z' {
+		p.pos++
+	}
+
+	name := p.expr[start:p.pos]
+
+	p.skipSpaces()
+	if p.done() || p.peek() != '(' {
+		return p.errorf("expected (")
+	}
+
+	p.pos++
+	p.skipSpaces()
+
+	if p.done() || (p.peek() != '\'' && p.peek() != '"') {
+		return p.errorf("expected quoted argument")
+	}
+
+	argument, err := p.readString()
+	if err != nil {
+		return err
+	}
+
+	p.skipSpaces()
+	if p.done() || p.peek() != ')' {
+		return p.errorf("expected )")
+	}
+
+	p.pos++
+
+	switch name {
+	case "join":
+		path.separator = argument
+	case "default":
+		path.def = &argument
+	default:
+		p.pos = start
+		return p.errorf("unknown modifier %q", name)
+	}
+
+	return nil
+}
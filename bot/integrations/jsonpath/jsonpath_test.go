@@ -0,0 +1,100 @@
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testBody = `{
+	"user": {"name": "Alice", "first name": "Al", "age": 30, "verified": true},
+	"key.with.dots": "dotted",
+	"roles": [
+		{"name": "Admin", "primary": false, "level": 3},
+		{"name": "Member", "primary": true, "level": 1}
+	],
+	"tags": ["a", "b", "c"],
+	"empty": null
+}`
+
+func decode(t *testing.T, body string) any {
+	decoder := json.NewDecoder(bytes.NewBufferString(body))
+	decoder.UseNumber()
+
+	var decoded any
+	require.NoError(t, decoder.Decode(&decoded))
+	return decoded
+}
+
+func extract(t *testing.T, body any, expr string) (string, bool) {
+	path, err := Parse(expr)
+	require.NoError(t, err, expr)
+	return path.Extract(body)
+}
+
+func TestExtract(t *testing.T) {
+	body := decode(t, testBody)
+
+	cases := map[string]string{
+		"user.name":                         "Alice",
+		"$.user.name":                       "Alice",
+		"user.age":                          "30",
+		"user.verified":                     "true",
+		"user.first name":                   "Al",
+		"['key.with.dots']":                 "dotted",
+		`$["user"]['name']`:                 "Alice",
+		"tags[0]":                           "a",
+		"tags[-1]":                          "c",
+		"tags[*]":                           "a, b, c",
+		"tags[*] | join(' / ')":             "a / b / c",
+		"roles[*].name":                     "Admin, Member",
+		"roles[?(@.primary)].name":          "Member",
+		"roles[?(@.name == 'Admin')].level": "3",
+		"roles[?(@.level != 1)].name":       "Admin",
+		"roles[?(@.level == 1.0)].name":     "Member",
+		"roles[1]":                          `{"level":1,"name":"Member","primary":true}`,
+		"missing | default('None')":         "None",
+		"empty | default(\"None\")":         "None",
+		"roles[?(@.primary)].name | default('No')": "Member",
+	}
+
+	for expr, expected := range cases {
+		actual, ok := extract(t, body, expr)
+		require.True(t, ok, expr)
+		require.Equal(t, expected, actual, expr)
+	}
+}
+
+func TestExtractMissing(t *testing.T) {
+	body := decode(t, testBody)
+
+	for _, expr := range []string{"missing", "user.name.first", "tags[3]", "tags.0", "empty", "roles[?(@.missing)].name"} {
+		_, ok := extract(t, body, expr)
+		require.False(t, ok, expr)
+	}
+}
+
+func TestExtractTopLevel(t *testing.T) {
+	array := decode(t, `[{"id": 1}, {"id": 2}]`)
+
+	actual, ok := extract(t, array, "[*].id")
+	require.True(t, ok)
+	require.Equal(t, "1, 2", actual)
+
+	actual, ok = extract(t, array, "$[1].id")
+	require.True(t, ok)
+	require.Equal(t, "2", actual)
+
+	actual, ok = extract(t, decode(t, `"hello"`), "$")
+	require.True(t, ok)
+	require.Equal(t, "hello", actual)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{"a[", "a[x]", "a[?(@.b]", "a['b", "a | unknown('x')", "a | join(x)", "a..b", "a[0] b"} {
+		_, err := Parse(expr)
+		require.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,156 @@
+package linkedaccounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations/jsonpath"
+)
+
+// HttpProviderConfig describes a provider backed by a JSON API, such as a Steam or game account linking service
+type HttpProviderConfig struct {
+	Name string `json:"name"`
+	// Url is requested with GET, with %user_id% replaced by the Discord user ID. A 404 response means that the user
+	// has not linked an account.
+	Url     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	// Placeholders maps placeholder names to paths into the response, using the jsonpath package syntax
+	Placeholders map[string]string `json:"placeholders"`
+}
+
+type HttpProvider struct {
+	client       *http.Client
+	config       HttpProviderConfig
+	placeholders []string
+	paths        map[string]*jsonpath.Path
+}
+
+var _ Provider = (*HttpProvider)(nil)
+
+// ParseHttpProviders parses a JSON array of HttpProviderConfig, as set in the worker configuration
+func ParseHttpProviders(client *http.Client, encoded string) ([]*HttpProvider, error) {
+	if strings.TrimSpace(encoded) == "" {
+		return nil, nil
+	}
+
+	var configs []HttpProviderConfig
+	if err := json.Unmarshal([]byte(encoded), &configs); err != nil {
+		return nil, fmt.Errorf("invalid linked account provider configuration: %w", err)
+	}
+
+	providers := make([]*HttpProvider, len(configs))
+	for i, config := range configs {
+		provider, err := NewHttpProvider(client, config)
+		if err != nil {
+			return nil, err
+		}
+
+		providers[i] = provider
+	}
+
+	return providers, nil
+}
+
+func NewHttpProvider(client *http.Client, config HttpProviderConfig) (*HttpProvider, error) {
+	if config.Name == "" {
+		return nil, errors.New("linked account provider is missing a name")
+	}
+
+	if !strings.HasPrefix(config.Url, "http://") && !strings.HasPrefix(config.Url, "https://") {
+		return nil, fmt.Errorf("linked account provider %s has an invalid URL", config.Name)
+	}
+
+	if len(config.Placeholders) == 0 {
+		return nil, fmt.Errorf("linked account provider %s has no placeholders", config.Name)
+	}
+
+	paths := make(map[string]*jsonpath.Path)
+	placeholders := make([]string, 0, len(config.Placeholders))
+	for placeholder, expr := range config.Placeholders {
+		path, err := jsonpath.Parse(expr)
+		if err != nil {
+			return nil, fmt.Errorf("linked account provider %s placeholder %s: %w", config.Name, placeholder, err)
+		}
+
+		paths[placeholder] = path
+		placeholders = append(placeholders, placeholder)
+	}
+
+	sort.Strings(placeholders)
+
+	return &HttpProvider{
+		client:       client,
+		config:       config,
+		placeholders: placeholders,
+		paths:        paths,
+	}, nil
+}
+
+func (p *HttpProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *HttpProvider) Placeholders() []string {
+	return p.placeholders
+}
+
+func (p *HttpProvider) Lookup(ctx context.Context, userId uint64) (map[string]string, error) {
+	url := strings.ReplaceAll(p.config.Url, "%user_id%", strconv.FormatUint(userId, 10))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range p.config.Headers {
+		req.Header.Set(name, value)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotLinked
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, fmt.Errorf("linked account provider %s returned status %d", p.config.Name, res.StatusCode)
+	}
+
+	// Limit the size of the response, as the provider is called on every ticket open
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1024*1024))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewBuffer(body))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for placeholder, path := range p.paths {
+		if value, ok := path.Extract(decoded); ok {
+			values[placeholder] = value
+		}
+	}
+
+	return values, nil
+}
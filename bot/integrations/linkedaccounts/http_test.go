@@ -0,0 +1,117 @@
+package linkedaccounts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/users/1":
+			_, _ = w.Write([]byte(`{"steam_id": "76561197960287930", "profile": {"name": "Alice", "level": 12}}`))
+		case "/users/2":
+			_, _ = w.Write([]byte(`{"steam_id": "76561197960287931", "profile": {}}`))
+		case "/users/3":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestProvider(t *testing.T, server *httptest.Server) *HttpProvider {
+	provider, err := NewHttpProvider(server.Client(), HttpProviderConfig{
+		Name:    "steam",
+		Url:     server.URL + "/users/%user_id%",
+		Headers: map[string]string{"Authorization": "secret"},
+		Placeholders: map[string]string{
+			"steam_id":    "steam_id",
+			"steam_name":  "profile.name",
+			"steam_level": "profile.level",
+		},
+	})
+	require.NoError(t, err)
+	return provider
+}
+
+func TestHttpProviderLookup(t *testing.T) {
+	provider := newTestProvider(t, newTestServer(t))
+
+	require.Equal(t, []string{"steam_id", "steam_level", "steam_name"}, provider.Placeholders())
+
+	values, err := provider.Lookup(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"steam_id":    "76561197960287930",
+		"steam_name":  "Alice",
+		"steam_level": "12",
+	}, values)
+
+	// Missing values are omitted, to be filled with N/A
+	values, err = provider.Lookup(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"steam_id": "76561197960287931"}, values)
+}
+
+func TestHttpProviderNotLinked(t *testing.T) {
+	provider := newTestProvider(t, newTestServer(t))
+
+	_, err := provider.Lookup(context.Background(), 4)
+	require.ErrorIs(t, err, ErrNotLinked)
+}
+
+func TestHttpProviderError(t *testing.T) {
+	provider := newTestProvider(t, newTestServer(t))
+
+	_, err := provider.Lookup(context.Background(), 3)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNotLinked)
+}
+
+func TestParseHttpProviders(t *testing.T) {
+	providers, err := ParseHttpProviders(http.DefaultClient, `[{"name": "steam", "url": "https://example.com/%user_id%", "placeholders": {"steam_id": "id"}}]`)
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+	require.Equal(t, "steam", providers[0].Name())
+
+	providers, err = ParseHttpProviders(http.DefaultClient, "")
+	require.NoError(t, err)
+	require.Empty(t, providers)
+
+	_, err = ParseHttpProviders(http.DefaultClient, `[{"name": "steam", "url": "https://example.com", "placeholders": {"steam_id": "id["}}]`)
+	require.Error(t, err)
+
+	_, err = ParseHttpProviders(http.DefaultClient, `[{"name": "steam", "url": "ftp://example.com", "placeholders": {"steam_id": "id"}}]`)
+	require.Error(t, err)
+}
+
+func TestNewRegistryConflicts(t *testing.T) {
+	a, err := NewHttpProvider(http.DefaultClient, HttpProviderConfig{Name: "a", Url: "https://a.example.com", Placeholders: map[string]string{"id": "id"}})
+	require.NoError(t, err)
+
+	b, err := NewHttpProvider(http.DefaultClient, HttpProviderConfig{Name: "b", Url: "https://b.example.com", Placeholders: map[string]string{"id": "id"}})
+	require.NoError(t, err)
+
+	_, err = NewRegistry(a, b)
+	require.Error(t, err)
+
+	_, err = NewRegistry(a, a)
+	require.Error(t, err)
+
+	registry, err := NewRegistry(a)
+	require.NoError(t, err)
+	require.Len(t, registry.Providers(), 1)
+}
@@ -0,0 +1,59 @@
+// Package linkedaccounts provides placeholders for accounts that users have linked to their Discord account on other
+// services, such as Roblox through Bloxlink.
+package linkedaccounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotLinked is returned by a provider when the user has not linked an account. The placeholders are filled with N/A.
+var ErrNotLinked = errors.New("user has not linked an account")
+
+type Provider interface {
+	// Name identifies the provider in the configuration
+	Name() string
+	// Placeholders lists the names of every placeholder that Lookup may return, without the surrounding % signs
+	Placeholders() []string
+	// Lookup returns the placeholder values for the Discord user, or ErrNotLinked
+	Lookup(ctx context.Context, userId uint64) (map[string]string, error)
+}
+
+type Registry struct {
+	providers []Provider
+}
+
+// NewRegistry returns a registry of the given providers, checking that no two providers share a name or placeholder
+func NewRegistry(providers ...Provider) (*Registry, error) {
+	names := make(map[string]bool)
+	placeholders := make(map[string]string) // placeholder -> provider name
+
+	for _, provider := range providers {
+		if names[provider.Name()] {
+			return nil, fmt.Errorf("linked account provider %s is registered more than once", provider.Name())
+		}
+
+		names[provider.Name()] = true
+
+		for _, placeholder := range provider.Placeholders() {
+			if other, ok := placeholders[placeholder]; ok {
+				return nil, fmt.Errorf("placeholder %s is provided by both %s and %s", placeholder, other, provider.Name())
+			}
+
+			placeholders[placeholder] = provider.Name()
+		}
+	}
+
+	return &Registry{
+		providers: providers,
+	}, nil
+}
+
+func (r *Registry) Providers() []Provider {
+	if r == nil {
+		return nil
+	}
+
+	return r.providers
+}
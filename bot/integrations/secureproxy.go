@@ -3,7 +3,6 @@ package integrations
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -46,7 +45,7 @@ func (p *SecureProxyClient) DoRequest(ctx context.Context, method, url string, h
 	if bodyData != nil && (method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch || method == http.MethodDelete) {
 		switch v := bodyData.(type) {
 		case []byte:
-			base64.StdEncoding.Encode(body.Body, v)
+			body.Body = v // Encoded as base64 when the proxy request is marshalled
 		case any:
 			encoded, err := json.Marshal(v)
 			if err != nil {
@@ -57,38 +56,67 @@ func (p *SecureProxyClient) DoRequest(ctx context.Context, method, url string, h
 		}
 	}
 
-	encoded, err := json.Marshal(body)
+	statusCode, resBody, err := p.send(ctx, body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.Url+"/proxy", bytes.NewBuffer(encoded))
+	if statusCode != 200 {
+		return nil, fmt.Errorf("integration request returned status code %d", statusCode)
+	}
+
+	return resBody, nil
+}
+
+// DoRawRequest sends the body bytes through the proxy unchanged, for requests whose body has been signed, and treats
+// any 2xx status as success
+func (p *SecureProxyClient) DoRawRequest(ctx context.Context, method, url string, headers map[string]string, bodyData []byte) ([]byte, error) {
+	statusCode, resBody, err := p.send(ctx, secureProxyRequest{
+		Method:  method,
+		Url:     url,
+		Headers: headers,
+		Body:    bodyData,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if statusCode < 200 || statusCode > 299 {
+		return nil, fmt.Errorf("request returned status code %d", statusCode)
+	}
+
+	return resBody, nil
+}
+
+func (p *SecureProxyClient) send(ctx context.Context, body secureProxyRequest) (int, []byte, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.Url+"/proxy", bytes.NewBuffer(encoded))
+	if err != nil {
+		return 0, nil, err
+	}
+
 	req.Header.Set("Content-Type", "application/json")
 
 	res, err := p.client.Do(req)
 	if err != nil {
 		fmt.Print(err)
-		return nil, errors.New("error proxying request")
+		return 0, nil, errors.New("error proxying request")
 	}
 
 	defer res.Body.Close()
 
 	if errorHeader := res.Header.Get("x-proxy-error"); errorHeader != "" {
-		return nil, errors.New(errorHeader)
-	}
-
-	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("integration request returned status code %d", res.StatusCode)
+		return 0, nil, errors.New(errorHeader)
 	}
 
 	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
 	}
 
-	return resBody, nil
+	return res.StatusCode, resBody, nil
 }
@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+)
+
+var errAccessIntegrationNotActive = errors.New("access check integration is no longer activated in the guild")
+
+// checkAccessIntegration asks the panel's access check integration, if it has one, whether the user may open a ticket.
+// If not, the user is told why, and false is returned.
+func checkAccessIntegration(ctx context.Context, cmd registry.InteractionContext, panel database.Panel, formData map[database.FormInput]string) (bool, error) {
+	accessIntegration, err := dbclient.Local.PanelAccessIntegrations.Get(ctx, panel.PanelId)
+	if err != nil {
+		return false, err
+	}
+
+	if accessIntegration == nil {
+		return true, nil
+	}
+
+	decision, err := fetchAccessDecision(ctx, cmd, panel, *accessIntegration, formData)
+	if err != nil {
+		cmd.HandleWarning(err)
+
+		if accessIntegration.AllowOnError {
+			return true, nil
+		}
+
+		cmd.Reply(customisation.Red, i18n.MessageNoPermission, i18n.MessageOpenAccessCheckFailed)
+		return false, nil
+	}
+
+	if decision.Allowed() {
+		return true, nil
+	}
+
+	if decision.Message == "" {
+		cmd.Reply(customisation.Red, i18n.MessageNoPermission, i18n.MessageOpenAccessCheckDenied)
+	} else {
+		cmd.ReplyRaw(customisation.Red, cmd.GetMessage(i18n.MessageNoPermission), utils.StringMax(decision.Message, 4096))
+	}
+
+	return false, nil
+}
+
+func fetchAccessDecision(
+	ctx context.Context,
+	cmd registry.InteractionContext,
+	panel database.Panel,
+	accessIntegration dbclient.PanelAccessIntegration,
+	formData map[database.FormInput]string,
+) (integrations.AccessDecision, error) {
+	// The integration may have been removed from the guild since the panel was configured
+	guildIntegrations, err := dbclient.Client.CustomIntegrationGuilds.GetGuildIntegrations(ctx, cmd.GuildId())
+	if err != nil {
+		return integrations.AccessDecision{}, err
+	}
+
+	var integration *database.CustomIntegration
+	for _, guildIntegration := range guildIntegrations {
+		if guildIntegration.Id == accessIntegration.IntegrationId {
+			guildIntegration := guildIntegration
+			integration = &guildIntegration
+			break
+		}
+	}
+
+	if integration == nil {
+		return integrations.AccessDecision{}, errAccessIntegrationNotActive
+	}
+
+	integrationIds := []int{integration.Id}
+
+	secrets, err := dbclient.Client.CustomIntegrationSecretValues.GetAll(ctx, cmd.GuildId(), integrationIds)
+	if err != nil {
+		return integrations.AccessDecision{}, err
+	}
+
+	headers, err := dbclient.Client.CustomIntegrationHeaders.GetAll(ctx, integrationIds)
+	if err != nil {
+		return integrations.AccessDecision{}, err
+	}
+
+	settings, err := dbclient.Local.CustomIntegrationSettings.GetAll(ctx, cmd.GuildId(), integrationIds)
+	if err != nil {
+		return integrations.AccessDecision{}, err
+	}
+
+	location, err := GetGuildLocation(ctx, cmd.GuildId())
+	if err != nil {
+		return integrations.AccessDecision{}, err
+	}
+
+	return integrations.CheckAccess(
+		ctx,
+		*integration,
+		settings[integration.Id],
+		cmd.GuildId(),
+		cmd.UserId(),
+		panel.PanelId,
+		secrets[integration.Id],
+		headers[integration.Id],
+		formAnswersToMap(formData),
+		location,
+	)
+}
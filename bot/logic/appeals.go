@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+)
+
+// IsAppealPanel returns whether the panel is the guild's blacklist appeals panel
+func IsAppealPanel(ctx context.Context, guildId uint64, panelId int) (bool, error) {
+	appealPanelId, err := dbclient.Local.BlacklistAppealPanel.Get(ctx, guildId)
+	if err != nil {
+		return false, err
+	}
+
+	return appealPanelId != nil && *appealPanelId == panelId, nil
+}
+
+// IsAppealPanelCustomId is the same as IsAppealPanel, but looks the panel up by its custom ID, for use before the
+// interaction has been dispatched to a handler.
+func IsAppealPanelCustomId(ctx context.Context, guildId uint64, customId string) (bool, error) {
+	panel, ok, err := dbclient.Client.Panel.GetByCustomId(ctx, guildId, customId)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return IsAppealPanel(ctx, guildId, panel.PanelId)
+}
+
+// IsBlacklistedFromPanel returns whether the user is blocked from opening a ticket from the panel. Blacklisted users
+// are only permitted to open tickets from the guild's appeals panel.
+func IsBlacklistedFromPanel(ctx context.Context, cmd registry.CommandContext, panel database.Panel) (bool, error) {
+	blacklisted, err := cmd.IsBlacklisted(ctx)
+	if err != nil || !blacklisted {
+		return false, err
+	}
+
+	isAppealPanel, err := IsAppealPanel(ctx, cmd.GuildId(), panel.PanelId)
+	if err != nil {
+		return false, err
+	}
+
+	return !isAppealPanel, nil
+}
+
+// isBlacklistAppeal returns whether a ticket opened by the user from the panel should be treated as an appeal
+func isBlacklistAppeal(ctx context.Context, cmd registry.CommandContext, panel *database.Panel) (bool, error) {
+	if panel == nil {
+		return false, nil
+	}
+
+	isAppealPanel, err := IsAppealPanel(ctx, cmd.GuildId(), panel.PanelId)
+	if err != nil || !isAppealPanel {
+		return false, err
+	}
+
+	return cmd.IsBlacklisted(ctx)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/permission"
@@ -42,11 +43,33 @@ func ClaimTicket(ctx context.Context, cmd registry.CommandContext, ticket databa
 		}
 	}
 
+	previousClaimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
 	// Set to claimed in DB
 	if err := dbclient.Client.TicketClaims.Set(ctx, ticket.GuildId, ticket.Id, userId); err != nil {
 		return err
 	}
 
+	// Claiming a ticket that someone else has already claimed transfers it to the new claimer
+	var webhookErr error
+	if previousClaimer != 0 && previousClaimer != userId {
+		webhookErr = webhooks.Dispatch(ctx, ticket.GuildId, ticket.Id, webhooks.EventTransferred, cmd.UserId(), webhooks.TransferData{
+			PreviousClaimerId: previousClaimer,
+			ClaimerId:         userId,
+		})
+	} else {
+		webhookErr = webhooks.Dispatch(ctx, ticket.GuildId, ticket.Id, webhooks.EventClaimed, cmd.UserId(), webhooks.ClaimData{
+			ClaimerId: userId,
+		})
+	}
+
+	if webhookErr != nil {
+		fmt.Print(webhookErr, cmd.ToErrorContext())
+	}
+
 	newOverwrites, err := GenerateClaimedOverwrites(ctx, cmd.Worker(), ticket, userId)
 	if err != nil {
 		return err
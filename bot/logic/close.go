@@ -16,6 +16,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/statsd"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel/embed"
 	"github.com/rxdn/gdl/objects/channel/message"
@@ -169,6 +170,18 @@ func CloseTicket(ctx context.Context, cmd registry.CommandContext, reason *strin
 		return
 	}
 
+	// Automatic closes are sent without an actor
+	var actorId uint64
+	if closeMetadata.ClosedBy != nil {
+		actorId = *closeMetadata.ClosedBy
+	}
+
+	if err := webhooks.Dispatch(ctx, ticket.GuildId, ticket.Id, webhooks.EventClosed, actorId, webhooks.CloseData{
+		Reason: reason,
+	}); err != nil {
+		fmt.Print(err, errorContext)
+	}
+
 	if ticket.IsThread {
 		// If it is a thread, we need to send a message
 		if reason == nil {
@@ -319,6 +332,12 @@ func sendCloseEmbed(ctx context.Context, cmd registry.CommandContext, member mem
 			return
 		}
 
+		feedbackSettings, err := dbclient.Local.FeedbackSettings.Get(ctx, cmd.GuildId())
+		if err != nil {
+			fmt.Print(err)
+			return
+		}
+
 		statsd.Client.IncrementKey(statsd.KeyDirectMessage)
 
 		componentBuilders := [][]CloseEmbedElement{
@@ -327,7 +346,7 @@ func sendCloseEmbed(ctx context.Context, cmd registry.CommandContext, member mem
 				ThreadLinkElement(ticket.IsThread && ticket.ChannelId != nil),
 			},
 			{
-				FeedbackRowElement(feedbackEnabled && hasSentMessage && permLevel == permission.Everyone),
+				FeedbackRowElement(feedbackEnabled && hasSentMessage && permLevel == permission.Everyone, feedbackSettings.Scale),
 			},
 		}
 
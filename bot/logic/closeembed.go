@@ -7,9 +7,11 @@ import (
 
 	database "github.com/jadevelopmentgrp/Tickets-Database"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/signing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel/embed"
 	"github.com/rxdn/gdl/objects/channel/message"
 	"github.com/rxdn/gdl/objects/guild/emoji"
@@ -36,17 +38,20 @@ func TranscriptLinkElement(condition bool) CloseEmbedElement {
 			transcriptEmoji = customisation.EmojiTranscript.BuildEmoji()
 		}
 
-		transcriptLink := fmt.Sprintf("https://dashboard.ticketsbot.net/manage/%d/transcripts/view/%d", ticket.GuildId, ticket.Id)
-
 		return utils.Slice(component.BuildButton(component.Button{
 			Label: "View Online Transcript",
 			Style: component.ButtonStyleLink,
 			Emoji: transcriptEmoji,
-			Url:   utils.Ptr(transcriptLink),
+			Url:   utils.Ptr(TranscriptUrl(ticket.GuildId, ticket.Id)),
 		}))
 	}
 }
 
+// TranscriptUrl returns the dashboard link to a ticket's transcript
+func TranscriptUrl(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("https://dashboard.ticketsbot.net/manage/%d/transcripts/view/%d", guildId, ticketId)
+}
+
 func ThreadLinkElement(condition bool) CloseEmbedElement {
 	if !condition {
 		return NoopElement()
@@ -78,7 +83,7 @@ func ViewFeedbackElement(condition bool) CloseEmbedElement {
 		return utils.Slice(
 			component.BuildButton(component.Button{
 				Label:    "View Exit Survey",
-				CustomId: fmt.Sprintf("view-survey-%d-%d", ticket.GuildId, ticket.Id),
+				CustomId: signing.Sign(fmt.Sprintf("view-survey-%d-%d", ticket.GuildId, ticket.Id)),
 				Style:    component.ButtonStylePrimary,
 				Emoji:    utils.BuildEmoji("📰"),
 			}),
@@ -86,45 +91,75 @@ func ViewFeedbackElement(condition bool) CloseEmbedElement {
 	}
 }
 
-func FeedbackRowElement(condition bool) CloseEmbedElement {
+// FeedbackRowElement builds a button for each score on the scale. Scales with more than 5 scores are split over
+// several rows by BuildCloseEmbed.
+func FeedbackRowElement(condition bool, scale dbclient.RatingScale) CloseEmbedElement {
 	if !condition {
 		return NoopElement()
 	}
 
 	return func(worker *worker.Context, ticket database.Ticket) []component.Component {
-		buttons := make([]component.Component, 5)
-
-		for i := 1; i <= 5; i++ {
-			var style component.ButtonStyle
-			if i <= 2 {
-				style = component.ButtonStyleDanger
-			} else if i == 3 {
-				style = component.ButtonStylePrimary
-			} else {
-				style = component.ButtonStyleSuccess
-			}
+		maxScore := RatingScaleMax(scale)
+		buttons := make([]component.Component, maxScore)
 
-			buttons[i-1] = component.BuildButton(component.Button{
+		for i := 1; i <= maxScore; i++ {
+			button := component.Button{
 				Label:    strconv.Itoa(i),
-				CustomId: fmt.Sprintf("rate_%d_%d_%d", ticket.GuildId, ticket.Id, i),
-				Style:    style,
-				Emoji: &emoji.Emoji{
+				CustomId: signing.Sign(fmt.Sprintf("rate_%s_%d_%d_%d", scale, ticket.GuildId, ticket.Id, i)),
+				Style:    ratingButtonStyle(scale, i),
+			}
+
+			switch scale {
+			case dbclient.RatingScaleStars:
+				button.Emoji = &emoji.Emoji{
 					Name: "⭐",
-				},
-			})
+				}
+			case dbclient.RatingScaleThumbs:
+				if i == 1 {
+					button.Label = i18n.GetMessageFromGuild(ticket.GuildId, i18n.MessageFeedbackThumbsDown)
+				} else {
+					button.Label = i18n.GetMessageFromGuild(ticket.GuildId, i18n.MessageFeedbackThumbsUp)
+				}
+
+				button.Emoji = utils.BuildEmoji(FormatRating(scale, i))
+			}
+
+			buttons[i-1] = component.BuildButton(button)
 		}
 
 		return buttons
 	}
 }
 
+// ratingButtonStyle colours low scores red, middling scores blue and high scores green. NPS counts 7 and 8 as
+// passive, and 9 and 10 as promoters.
+func ratingButtonStyle(scale dbclient.RatingScale, score int) component.ButtonStyle {
+	var low, middle int
+	switch scale {
+	case dbclient.RatingScaleNps:
+		low, middle = 6, 8
+	case dbclient.RatingScaleThumbs:
+		low, middle = 1, 1
+	default:
+		low, middle = 2, 3
+	}
+
+	if score <= low {
+		return component.ButtonStyleDanger
+	} else if score <= middle {
+		return component.ButtonStylePrimary
+	} else {
+		return component.ButtonStyleSuccess
+	}
+}
+
 func BuildCloseEmbed(
 	ctx context.Context,
 	worker *worker.Context,
 	ticket database.Ticket,
 	closedBy uint64,
 	reason *string,
-	rating *uint8,
+	rating *dbclient.FeedbackRating,
 	components [][]CloseEmbedElement,
 ) (*embed.Embed, []component.Component) {
 	var formattedReason string
@@ -174,7 +209,7 @@ func BuildCloseEmbed(
 	if rating == nil {
 		closeEmbed = closeEmbed.AddBlankField(true)
 	} else {
-		closeEmbed = closeEmbed.AddField(formatTitle("Rating", customisation.EmojiRating, worker.IsWhitelabel), fmt.Sprintf("%d ⭐", *rating), true)
+		closeEmbed = closeEmbed.AddField(formatTitle("Rating", customisation.EmojiRating, worker.IsWhitelabel), FormatRating(rating.Scale, rating.Score), true)
 	}
 
 	closeEmbed = closeEmbed.AddField(formatTitle("Reason", customisation.EmojiReason, worker.IsWhitelabel), formattedReason, false)
@@ -186,8 +221,11 @@ func BuildCloseEmbed(
 			rowElements = append(rowElements, element(worker, ticket)...)
 		}
 
-		if len(rowElements) > 0 {
-			rows = append(rows, component.BuildActionRow(rowElements...))
+		// Discord allows at most 5 buttons in a row
+		for len(rowElements) > 0 {
+			size := min(len(rowElements), 5)
+			rows = append(rows, component.BuildActionRow(rowElements[:size]...))
+			rowElements = rowElements[size:]
 		}
 	}
 
@@ -210,7 +248,7 @@ func EditGuildArchiveMessageIfExists(
 	viewFeedbackButton bool,
 	closedBy uint64,
 	reason *string,
-	rating *uint8,
+	rating *dbclient.FeedbackRating,
 ) error {
 	archiveMessage, ok, err := dbclient.Client.ArchiveMessages.Get(ctx, ticket.GuildId, ticket.Id)
 	if err != nil {
@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"strings"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+)
+
+// UnrestrictableCommand and its subcommands can never be disabled or restricted, otherwise admins would have no way to
+// re-enable commands
+const UnrestrictableCommand = "managecommands"
+
+// IsUnrestrictableCommand returns whether the space separated command path is UnrestrictableCommand or one of its
+// subcommands
+func IsUnrestrictableCommand(path string) bool {
+	root, _, _ := strings.Cut(path, " ")
+	return root == UnrestrictableCommand
+}
+
+// CommandRestrictionKeys returns the keys that restrictions on the command at the given path, or any of its parents,
+// are stored under. For example, /setup limit returns "setup" and "setup limit".
+func CommandRestrictionKeys(path []string) []string {
+	keys := make([]string, len(path))
+	for i := range path {
+		keys[i] = strings.Join(path[:i+1], " ")
+	}
+
+	return keys
+}
+
+// CheckCommandRestrictions returns whether a member may run a command, given the restrictions on the command and its
+// parents. If they may not, the reason to show the member is returned. Admins are exempt from role restrictions, but
+// disabled commands are disabled for everyone.
+func CheckCommandRestrictions(
+	restrictions []dbclient.CommandRestriction,
+	roles []uint64,
+	permLevel permission.PermissionLevel,
+) (bool, i18n.MessageId) {
+	for _, restriction := range restrictions {
+		// Restrictions stored before subcommands were protected are ignored
+		if IsUnrestrictableCommand(restriction.Command) {
+			continue
+		}
+
+		if restriction.Disabled {
+			return false, i18n.MessageCommandDisabled
+		}
+	}
+
+	if permLevel >= permission.Admin {
+		return true, ""
+	}
+
+	for _, restriction := range restrictions {
+		if IsUnrestrictableCommand(restriction.Command) {
+			continue
+		}
+
+		if len(restriction.AllowedRoles) > 0 && !hasAnyRole(roles, restriction.AllowedRoles) {
+			return false, i18n.MessageCommandRoleRestricted
+		}
+	}
+
+	return true, ""
+}
+
+func hasAnyRole(roles, allowed []uint64) bool {
+	for _, role := range roles {
+		for _, allowedRole := range allowed {
+			if role == allowedRole {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+)
+
+// RatingScales lists the scales that a guild can choose from
+var RatingScales = []dbclient.RatingScale{dbclient.RatingScaleStars, dbclient.RatingScaleNps, dbclient.RatingScaleThumbs}
+
+// RatingScaleMax returns the highest score on the scale. Scores on every scale start at 1, and thumbs down is 1 and
+// thumbs up is 2.
+func RatingScaleMax(scale dbclient.RatingScale) int {
+	switch scale {
+	case dbclient.RatingScaleNps:
+		return 10
+	case dbclient.RatingScaleThumbs:
+		return 2
+	default:
+		return 5
+	}
+}
+
+func IsValidRatingScale(scale dbclient.RatingScale) bool {
+	for _, s := range RatingScales {
+		if s == scale {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RatingToStars converts a score to the 1-5 stars stored in the shared service ratings table, which the average
+// rating is calculated from
+func RatingToStars(scale dbclient.RatingScale, score int) uint8 {
+	switch scale {
+	case dbclient.RatingScaleNps:
+		return uint8(1 + math.Round(float64(score-1)*4/9))
+	case dbclient.RatingScaleThumbs:
+		if score >= 2 {
+			return 5
+		}
+
+		return 1
+	default:
+		return uint8(score)
+	}
+}
+
+func FormatRating(scale dbclient.RatingScale, score int) string {
+	switch scale {
+	case dbclient.RatingScaleNps:
+		return fmt.Sprintf("%d / 10", score)
+	case dbclient.RatingScaleThumbs:
+		if score >= 2 {
+			return "👍"
+		}
+
+		return "👎"
+	default:
+		return fmt.Sprintf("%d ⭐", score)
+	}
+}
+
+// ShouldAskRatingReason returns whether the user should be asked why they gave the score. Ratings given on a scale
+// the guild has since moved away from are never asked about, as the threshold is for the new scale.
+func ShouldAskRatingReason(settings dbclient.FeedbackSettings, scale dbclient.RatingScale, score int) bool {
+	return settings.ReasonThreshold != nil && settings.Scale == scale && score <= *settings.ReasonThreshold
+}
+
+// GetFeedbackRating returns the rating given to the ticket, or nil if it has not been rated. Tickets rated before
+// rating scales were configurable only have a star rating.
+func GetFeedbackRating(ctx context.Context, guildId uint64, ticketId int) (*dbclient.FeedbackRating, error) {
+	rating, ok, err := dbclient.Local.FeedbackRatings.Get(ctx, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		return &rating, nil
+	}
+
+	stars, ok, err := dbclient.Client.ServiceRatings.Get(ctx, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &dbclient.FeedbackRating{
+		GuildId:  guildId,
+		TicketId: ticketId,
+		Scale:    dbclient.RatingScaleStars,
+		Score:    int(stars),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+)
+
+// formPageSize is the maximum number of text inputs that Discord allows in a modal
+const formPageSize = 5
+
+// GetFormPages loads the conditions of the form's inputs and splits the inputs into modal pages, see BuildFormPages
+func GetFormPages(ctx context.Context, inputs []database.FormInput, answers map[string]string) ([][]database.FormInput, error) {
+	inputIds := make([]int, len(inputs))
+	for i, input := range inputs {
+		inputIds[i] = input.Id
+	}
+
+	conditions, err := dbclient.Local.FormInputConditions.GetAll(ctx, inputIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildFormPages(inputs, conditions, answers), nil
+}
+
+// BuildFormPages splits the form's inputs into modal pages, in form order, omitting inputs whose condition is not met
+// by the answers given so far, keyed by input custom ID. An input whose condition depends on an input on the same page
+// starts a new page, so that the condition can be evaluated before it is asked. Inputs that depend on unanswered inputs
+// are assumed to be asked, so pages after the next unanswered page may change as the user answers the form.
+func BuildFormPages(inputs []database.FormInput, conditions map[int]dbclient.FormInputCondition, answers map[string]string) [][]database.FormInput {
+	sorted := make([]database.FormInput, len(inputs))
+	copy(sorted, inputs)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	byId := make(map[int]database.FormInput)
+	for _, input := range sorted {
+		byId[input.Id] = input
+	}
+
+	var pages [][]database.FormInput
+	var current []database.FormInput
+
+	hidden := make(map[int]bool)
+	pageOf := make(map[int]int)
+
+	for _, input := range sorted {
+		condition, ok := conditions[input.Id]
+
+		// Conditions on inputs that have been moved after this input, or to another form, can't be evaluated
+		// before the input is asked, so are ignored
+		if dependency, exists := byId[condition.DependsOnInputId]; ok && exists && dependency.Position < input.Position {
+			if hidden[dependency.Id] {
+				hidden[input.Id] = true
+				continue
+			}
+
+			if page, asked := pageOf[dependency.Id]; asked && page == len(pages) {
+				pages = append(pages, current)
+				current = nil
+			}
+
+			if answer, answered := answers[dependency.CustomId]; answered && !FormConditionMet(condition, answer) {
+				hidden[input.Id] = true
+				continue
+			}
+		}
+
+		if len(current) == formPageSize {
+			pages = append(pages, current)
+			current = nil
+		}
+
+		pageOf[input.Id] = len(pages)
+		current = append(current, input)
+	}
+
+	if len(current) > 0 {
+		pages = append(pages, current)
+	}
+
+	return pages
+}
+
+// FormConditionMet evaluates a condition against the answer to the input it depends on. Comparisons ignore case and
+// surrounding whitespace.
+func FormConditionMet(condition dbclient.FormInputCondition, answer string) bool {
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	value := strings.ToLower(strings.TrimSpace(condition.Value))
+
+	switch condition.Operator {
+	case dbclient.FormConditionEquals:
+		return answer == value
+	case dbclient.FormConditionNotEquals:
+		return answer != value
+	case dbclient.FormConditionContains:
+		return strings.Contains(answer, value)
+	default:
+		return true
+	}
+}
+
+// FormPageAnswers returns the answers to the inputs on the pages, omitting the inputs that were not asked
+func FormPageAnswers(pages [][]database.FormInput, answers map[string]string) map[database.FormInput]string {
+	formData := make(map[database.FormInput]string)
+	for _, page := range pages {
+		for _, input := range page {
+			if answer, ok := answers[input.CustomId]; ok {
+				formData[input] = answer
+			}
+		}
+	}
+
+	return formData
+}
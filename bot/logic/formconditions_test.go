@@ -0,0 +1,181 @@
+package logic
+
+import (
+	"fmt"
+	"testing"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/stretchr/testify/require"
+)
+
+// testInputs builds inputs with the given IDs, positioned in the order given, with custom IDs of the form "input_<id>"
+func testInputs(ids ...int) []database.FormInput {
+	inputs := make([]database.FormInput, len(ids))
+	for i, id := range ids {
+		inputs[i] = database.FormInput{
+			Id:       id,
+			Position: i + 1,
+			CustomId: fmt.Sprintf("input_%d", id),
+		}
+	}
+
+	return inputs
+}
+
+func equalsCondition(inputId, dependsOn int, value string) dbclient.FormInputCondition {
+	return dbclient.FormInputCondition{
+		FormInputId:      inputId,
+		DependsOnInputId: dependsOn,
+		Operator:         dbclient.FormConditionEquals,
+		Value:            value,
+	}
+}
+
+func TestBuildFormPages(t *testing.T) {
+	tests := []struct {
+		name       string
+		inputs     []database.FormInput
+		conditions []dbclient.FormInputCondition
+		answers    map[string]string
+		expected   [][]int
+	}{
+		{
+			name:     "no conditions fit on one page",
+			inputs:   testInputs(1, 2, 3),
+			expected: [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "no conditions split into pages of 5",
+			inputs:   testInputs(1, 2, 3, 4, 5, 6, 7),
+			expected: [][]int{{1, 2, 3, 4, 5}, {6, 7}},
+		},
+		{
+			name: "inputs are ordered by position",
+			inputs: []database.FormInput{
+				{Id: 1, Position: 3, CustomId: "input_1"},
+				{Id: 2, Position: 1, CustomId: "input_2"},
+				{Id: 3, Position: 2, CustomId: "input_3"},
+			},
+			expected: [][]int{{2, 3, 1}},
+		},
+		{
+			name:       "unanswered dependency on the same page starts a new page",
+			inputs:     testInputs(1, 2, 3),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes")},
+			expected:   [][]int{{1}, {2, 3}},
+		},
+		{
+			name:       "met condition shows the input",
+			inputs:     testInputs(1, 2, 3),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes")},
+			answers:    map[string]string{"input_1": " Yes "},
+			expected:   [][]int{{1}, {2, 3}},
+		},
+		{
+			name:       "unmet condition hides the input",
+			inputs:     testInputs(1, 2, 3),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes")},
+			answers:    map[string]string{"input_1": "no"},
+			expected:   [][]int{{1}, {3}},
+		},
+		{
+			name:       "hidden inputs do not count towards the page size",
+			inputs:     testInputs(1, 2, 3, 4, 5, 6, 7),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes")},
+			answers:    map[string]string{"input_1": "no"},
+			expected:   [][]int{{1}, {3, 4, 5, 6, 7}},
+		},
+		{
+			name:       "dependency on an earlier page does not start a new page",
+			inputs:     testInputs(1, 2, 3, 4),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes"), equalsCondition(4, 1, "yes")},
+			answers:    map[string]string{"input_1": "yes"},
+			expected:   [][]int{{1}, {2, 3, 4}},
+		},
+		{
+			name:       "chained conditions are asked a page at a time",
+			inputs:     testInputs(1, 2, 3),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes"), equalsCondition(3, 2, "yes")},
+			expected:   [][]int{{1}, {2}, {3}},
+		},
+		{
+			name:       "chained conditions show every met input",
+			inputs:     testInputs(1, 2, 3),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes"), equalsCondition(3, 2, "yes")},
+			answers:    map[string]string{"input_1": "yes", "input_2": "yes"},
+			expected:   [][]int{{1}, {2}, {3}},
+		},
+		{
+			name:       "hiding an input hides the inputs that depend on it",
+			inputs:     testInputs(1, 2, 3, 4),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes"), equalsCondition(3, 2, "no")},
+			answers:    map[string]string{"input_1": "no"},
+			expected:   [][]int{{1}, {4}},
+		},
+		{
+			name:       "stale answer to a hidden input is ignored",
+			inputs:     testInputs(1, 2, 3),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 1, "yes"), equalsCondition(3, 2, "yes")},
+			answers:    map[string]string{"input_1": "no", "input_2": "yes"},
+			expected:   [][]int{{1}},
+		},
+		{
+			name:       "condition on a later input is ignored",
+			inputs:     testInputs(1, 2),
+			conditions: []dbclient.FormInputCondition{equalsCondition(1, 2, "yes")},
+			expected:   [][]int{{1, 2}},
+		},
+		{
+			name:       "condition on an input from another form is ignored",
+			inputs:     testInputs(1, 2),
+			conditions: []dbclient.FormInputCondition{equalsCondition(2, 99, "yes")},
+			expected:   [][]int{{1, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := make(map[int]dbclient.FormInputCondition)
+			for _, condition := range tt.conditions {
+				conditions[condition.FormInputId] = condition
+			}
+
+			pages := BuildFormPages(tt.inputs, conditions, tt.answers)
+
+			ids := make([][]int, len(pages))
+			for i, page := range pages {
+				for _, input := range page {
+					ids[i] = append(ids[i], input.Id)
+				}
+			}
+
+			require.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestFormConditionMet(t *testing.T) {
+	tests := []struct {
+		operator dbclient.FormConditionOperator
+		value    string
+		answer   string
+		expected bool
+	}{
+		{dbclient.FormConditionEquals, "yes", "yes", true},
+		{dbclient.FormConditionEquals, "Yes", "  yES ", true},
+		{dbclient.FormConditionEquals, "yes", "no", false},
+		{dbclient.FormConditionNotEquals, "yes", "no", true},
+		{dbclient.FormConditionNotEquals, "yes", "YES", false},
+		{dbclient.FormConditionContains, "crash", "The game Crashes", true},
+		{dbclient.FormConditionContains, "crash", "It lags", false},
+		{dbclient.FormConditionOperator("unknown"), "yes", "no", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %q %q", tt.operator, tt.value, tt.answer), func(t *testing.T) {
+			condition := dbclient.FormInputCondition{Operator: tt.operator, Value: tt.value}
+			require.Equal(t, tt.expected, FormConditionMet(condition, tt.answer))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"context"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+)
+
+// minSnowflake is the first snowflake that can be generated after the Discord epoch has a non-zero timestamp
+const minSnowflake = 1 << 22
+
+// FormValidationError describes why an answer to a form input was rejected
+type FormValidationError struct {
+	Message i18n.MessageId
+	Format  []interface{}
+}
+
+func newFormValidationError(message i18n.MessageId, format ...interface{}) *FormValidationError {
+	return &FormValidationError{
+		Message: message,
+		Format:  format,
+	}
+}
+
+// ValidateFormAnswers checks the answers against the required flag and any validation rules of each input, returning
+// the inputs with invalid answers
+func ValidateFormAnswers(ctx context.Context, formAnswers map[database.FormInput]string) (map[database.FormInput]FormValidationError, error) {
+	inputIds := make([]int, 0, len(formAnswers))
+	for input := range formAnswers {
+		inputIds = append(inputIds, input.Id)
+	}
+
+	rules, err := dbclient.Local.FormInputValidation.GetAll(ctx, inputIds)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := make(map[database.FormInput]FormValidationError)
+	for input, answer := range formAnswers {
+		// Check that users have not just pressed newline or space
+		if strings.TrimSpace(answer) == "" {
+			if input.Required {
+				invalid[input] = *newFormValidationError(i18n.MessageFormValidationRequired)
+			}
+
+			continue
+		}
+
+		rule, ok := rules[input.Id]
+		if !ok {
+			continue
+		}
+
+		if validationError := ValidateFormAnswer(rule, answer); validationError != nil {
+			invalid[input] = *validationError
+		}
+	}
+
+	return invalid, nil
+}
+
+// ValidateFormAnswer checks a non-empty answer against a validation rule, returning nil if it is valid
+func ValidateFormAnswer(rule dbclient.FormInputValidation, answer string) *FormValidationError {
+	answer = strings.TrimSpace(answer)
+
+	length := utf8.RuneCountInString(answer)
+	if rule.MinLength != nil && length < *rule.MinLength {
+		return newFormValidationError(i18n.MessageFormValidationTooShort, *rule.MinLength)
+	}
+
+	if rule.MaxLength != nil && length > *rule.MaxLength {
+		return newFormValidationError(i18n.MessageFormValidationTooLong, *rule.MaxLength)
+	}
+
+	switch rule.Type {
+	case dbclient.FormValidationRegex:
+		if rule.Pattern == nil {
+			return nil
+		}
+
+		// Patterns are checked when they are set, but may have been written to the database directly
+		pattern, err := regexp.Compile(*rule.Pattern)
+		if err != nil {
+			return nil
+		}
+
+		if !pattern.MatchString(answer) {
+			return newFormValidationError(i18n.MessageFormValidationPattern)
+		}
+	case dbclient.FormValidationInteger:
+		value, err := strconv.ParseInt(answer, 10, 64)
+		if err != nil {
+			return newFormValidationError(i18n.MessageFormValidationInteger)
+		}
+
+		return validateFormRange(rule, float64(value))
+	case dbclient.FormValidationDecimal:
+		value, err := strconv.ParseFloat(answer, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return newFormValidationError(i18n.MessageFormValidationDecimal)
+		}
+
+		return validateFormRange(rule, value)
+	case dbclient.FormValidationEmail:
+		// ParseAddress also accepts display names, e.g. "Name <name@example.com>", which we do not want
+		address, err := mail.ParseAddress(answer)
+		if err != nil || address.Address != answer {
+			return newFormValidationError(i18n.MessageFormValidationEmail)
+		}
+	case dbclient.FormValidationUrl:
+		parsed, err := url.ParseRequestURI(answer)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return newFormValidationError(i18n.MessageFormValidationUrl)
+		}
+	case dbclient.FormValidationSnowflake:
+		value, err := strconv.ParseUint(answer, 10, 64)
+		if err != nil || value < minSnowflake {
+			return newFormValidationError(i18n.MessageFormValidationSnowflake)
+		}
+	}
+
+	return nil
+}
+
+func validateFormRange(rule dbclient.FormInputValidation, value float64) *FormValidationError {
+	if rule.MinValue != nil && value < *rule.MinValue {
+		return newFormValidationError(i18n.MessageFormValidationTooSmall, formatFormValue(*rule.MinValue))
+	}
+
+	if rule.MaxValue != nil && value > *rule.MaxValue {
+		return newFormValidationError(i18n.MessageFormValidationTooLarge, formatFormValue(*rule.MaxValue))
+	}
+
+	return nil
+}
+
+func formatFormValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,165 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFormAnswer(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     dbclient.FormInputValidation
+		answer   string
+		expected *FormValidationError
+	}{
+		{
+			name:   "text within length",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationText, MinLength: utils.Ptr(2), MaxLength: utils.Ptr(5)},
+			answer: "abc",
+		},
+		{
+			name:     "text too short",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationText, MinLength: utils.Ptr(4)},
+			answer:   "abc",
+			expected: newFormValidationError(i18n.MessageFormValidationTooShort, 4),
+		},
+		{
+			name:     "text too long",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationText, MaxLength: utils.Ptr(2)},
+			answer:   "abc",
+			expected: newFormValidationError(i18n.MessageFormValidationTooLong, 2),
+		},
+		{
+			name:   "length counts characters, not bytes",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationText, MaxLength: utils.Ptr(5)},
+			answer: "héllo",
+		},
+		{
+			name:   "length ignores surrounding whitespace",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationText, MaxLength: utils.Ptr(3)},
+			answer: "  abc\n",
+		},
+		{
+			name:   "regex match",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationRegex, Pattern: utils.Ptr(`^[A-Z]{3}-\d+$`)},
+			answer: "ABC-123",
+		},
+		{
+			name:     "regex mismatch",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationRegex, Pattern: utils.Ptr(`^[A-Z]{3}-\d+$`)},
+			answer:   "abc-123",
+			expected: newFormValidationError(i18n.MessageFormValidationPattern),
+		},
+		{
+			name:   "invalid stored regex is ignored",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationRegex, Pattern: utils.Ptr(`(`)},
+			answer: "anything",
+		},
+		{
+			name:   "integer",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationInteger},
+			answer: "-42",
+		},
+		{
+			name:     "integer rejects decimals",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationInteger},
+			answer:   "4.2",
+			expected: newFormValidationError(i18n.MessageFormValidationInteger),
+		},
+		{
+			name:     "integer below min value",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationInteger, MinValue: utils.Ptr(1.0)},
+			answer:   "0",
+			expected: newFormValidationError(i18n.MessageFormValidationTooSmall, "1"),
+		},
+		{
+			name:     "integer above max value",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationInteger, MaxValue: utils.Ptr(10.0)},
+			answer:   "11",
+			expected: newFormValidationError(i18n.MessageFormValidationTooLarge, "10"),
+		},
+		{
+			name:   "integer on range boundary",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationInteger, MinValue: utils.Ptr(1.0), MaxValue: utils.Ptr(10.0)},
+			answer: "10",
+		},
+		{
+			name:   "decimal",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationDecimal, MaxValue: utils.Ptr(2.5)},
+			answer: "2.25",
+		},
+		{
+			name:     "decimal above max value",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationDecimal, MaxValue: utils.Ptr(2.5)},
+			answer:   "2.75",
+			expected: newFormValidationError(i18n.MessageFormValidationTooLarge, "2.5"),
+		},
+		{
+			name:     "decimal rejects NaN",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationDecimal},
+			answer:   "NaN",
+			expected: newFormValidationError(i18n.MessageFormValidationDecimal),
+		},
+		{
+			name:     "decimal rejects infinity",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationDecimal},
+			answer:   "+Inf",
+			expected: newFormValidationError(i18n.MessageFormValidationDecimal),
+		},
+		{
+			name:   "email",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationEmail},
+			answer: "name@example.com",
+		},
+		{
+			name:     "email rejects display names",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationEmail},
+			answer:   "Name <name@example.com>",
+			expected: newFormValidationError(i18n.MessageFormValidationEmail),
+		},
+		{
+			name:   "url",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationUrl},
+			answer: "https://example.com/path?query=1",
+		},
+		{
+			name:     "url rejects other schemes",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationUrl},
+			answer:   "javascript:alert(1)",
+			expected: newFormValidationError(i18n.MessageFormValidationUrl),
+		},
+		{
+			name:     "url requires a host",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationUrl},
+			answer:   "https://",
+			expected: newFormValidationError(i18n.MessageFormValidationUrl),
+		},
+		{
+			name:   "snowflake",
+			rule:   dbclient.FormInputValidation{Type: dbclient.FormValidationSnowflake},
+			answer: "508391840525975553",
+		},
+		{
+			name:     "snowflake rejects small numbers",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationSnowflake},
+			answer:   "12345",
+			expected: newFormValidationError(i18n.MessageFormValidationSnowflake),
+		},
+		{
+			name:     "snowflake rejects mentions",
+			rule:     dbclient.FormInputValidation{Type: dbclient.FormValidationSnowflake},
+			answer:   "<@508391840525975553>",
+			expected: newFormValidationError(i18n.MessageFormValidationSnowflake),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, ValidateFormAnswer(tt.rule, tt.answer))
+		})
+	}
+}
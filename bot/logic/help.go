@@ -0,0 +1,360 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/guild/emoji"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+const (
+	helpPageSize      = 10
+	helpSearchResults = 10
+)
+
+// BuildHelpMessage builds a page of the /help menu, listing the commands in a category that the user is able to run.
+// If category is -1, the first category with any commands is shown.
+func BuildHelpMessage(ctx context.Context, cmd registry.CommandContext, commands registry.Registry, category, page int) (*embed.Embed, []component.Component, error) {
+	categorised, err := helpCommandsByCategory(ctx, cmd, commands)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if category < 0 || category >= len(command.Categories) || len(categorised[category]) == 0 {
+		category = -1
+		for i, categoryCommands := range categorised {
+			if len(categoryCommands) > 0 {
+				category = i
+				break
+			}
+		}
+
+		page = 0
+	}
+
+	e := newHelpEmbed(cmd)
+	if category == -1 {
+		e.SetDescription(cmd.GetMessage(i18n.MessageHelpNoResults))
+		return e, nil, nil
+	}
+
+	categoryCommands := categorised[category]
+	pageCount := (len(categoryCommands) + helpPageSize - 1) / helpPageSize
+	if page < 0 || page >= pageCount {
+		page = 0
+	}
+
+	lower := page * helpPageSize
+	upper := lower + helpPageSize
+	if upper > len(categoryCommands) {
+		upper = len(categoryCommands)
+	}
+
+	lines, err := formatHelpLines(cmd, categoryCommands[lower:upper])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e.AddField(string(command.Categories[category]), strings.Join(lines, "\n"), false)
+	e.SetFooter(cmd.GetMessage(i18n.MessageHelpPage, page+1, pageCount), "https://avatars.githubusercontent.com/u/142818403")
+
+	// There is always a category to select here, as the category shown has commands
+	categorySelect, _ := buildHelpCategorySelect(cmd, categorised, category)
+
+	components := []component.Component{
+		categorySelect,
+		component.BuildActionRow(
+			component.BuildButton(component.Button{
+				CustomId: fmt.Sprintf("help_page_%d_%d", category, page-1),
+				Style:    component.ButtonStylePrimary,
+				Emoji:    &emoji.Emoji{Name: "◀️"},
+				Disabled: page <= 0,
+			}),
+			component.BuildButton(component.Button{
+				CustomId: fmt.Sprintf("help_page_%d_%d", category, page+1),
+				Style:    component.ButtonStylePrimary,
+				Emoji:    &emoji.Emoji{Name: "▶️"},
+				Disabled: page >= pageCount-1,
+			}),
+		),
+	}
+
+	return e, components, nil
+}
+
+// BuildHelpSearchMessage lists the commands whose name, aliases or description best match the query
+func BuildHelpSearchMessage(ctx context.Context, cmd registry.CommandContext, commands registry.Registry, query string) (*embed.Embed, []component.Component, error) {
+	categorised, err := helpCommandsByCategory(ctx, cmd, commands)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type match struct {
+		cmd   registry.Command
+		score int
+	}
+
+	var matches []match
+	for _, categoryCommands := range categorised {
+		for _, c := range categoryCommands {
+			if score := helpMatchScore(cmd, c, query); score > 0 {
+				matches = append(matches, match{c, score})
+			}
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+
+		return matches[i].cmd.Properties().Name < matches[j].cmd.Properties().Name
+	})
+
+	if len(matches) > helpSearchResults {
+		matches = matches[:helpSearchResults]
+	}
+
+	e := newHelpEmbed(cmd)
+
+	var components []component.Component
+	if categorySelect, ok := buildHelpCategorySelect(cmd, categorised, -1); ok {
+		components = []component.Component{categorySelect}
+	}
+
+	if len(matches) == 0 {
+		e.SetDescription(cmd.GetMessage(i18n.MessageHelpNoResults))
+		return e, components, nil
+	}
+
+	results := make([]registry.Command, len(matches))
+	for i, match := range matches {
+		results[i] = match.cmd
+	}
+
+	lines, err := formatHelpLines(cmd, results)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e.AddField(cmd.GetMessage(i18n.MessageHelpSearchResults, utils.EscapeMarkdown(query)), strings.Join(lines, "\n"), false)
+
+	return e, components, nil
+}
+
+func newHelpEmbed(cmd registry.CommandContext) *embed.Embed {
+	return embed.NewEmbed().
+		SetColor(cmd.GetColour(customisation.Green)).
+		SetTitle(cmd.GetMessage(i18n.TitleHelp)).
+		SetFooter("Tickets by jaDevelopment", "https://avatars.githubusercontent.com/u/142818403")
+}
+
+// buildHelpCategorySelect offers the categories that the user can run commands in. ok is false if there are none, as
+// Discord rejects select menus without any options.
+func buildHelpCategorySelect(cmd registry.CommandContext, categorised [][]registry.Command, selected int) (categorySelect component.Component, ok bool) {
+	options := make([]component.SelectOption, 0, len(command.Categories))
+	for i, category := range command.Categories {
+		if len(categorised[i]) == 0 {
+			continue
+		}
+
+		options = append(options, component.SelectOption{
+			Label:   string(category),
+			Value:   fmt.Sprintf("%d", i),
+			Default: i == selected,
+		})
+	}
+
+	if len(options) == 0 {
+		return component.Component{}, false
+	}
+
+	return component.BuildActionRow(component.BuildSelectMenu(component.SelectMenu{
+		CustomId:    "help_category",
+		Options:     options,
+		Placeholder: cmd.GetMessage(i18n.MessageHelpSelectCategory),
+	})), true
+}
+
+func formatHelpLines(cmd registry.CommandContext, commands []registry.Command) ([]string, error) {
+	commandIds, err := command.LoadCommandIds(cmd.Worker(), cmd.Worker().BotId)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, len(commands))
+	for i, c := range commands {
+		var commandId *uint64
+		if tmp, ok := commandIds[c.Properties().Name]; ok {
+			commandId = &tmp
+		}
+
+		lines[i] = registry.FormatHelp(c, cmd.GuildId(), commandId)
+	}
+
+	return lines, nil
+}
+
+// helpCommandsByCategory returns the commands the user is able to run, indexed in the same order as
+// command.Categories, and sorted by name within each category
+func helpCommandsByCategory(ctx context.Context, cmd registry.CommandContext, commands registry.Registry) ([][]registry.Command, error) {
+	permLevel, err := cmd.UserPermissionLevel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restrictions, err := dbclient.Local.CommandRestrictions.GetAll(ctx, cmd.GuildId())
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := cmd.Member()
+	if err != nil {
+		return nil, err
+	}
+
+	categorised := make([][]registry.Command, len(command.Categories))
+	for _, c := range commands {
+		properties := c.Properties()
+
+		// check bot admin / helper only commands
+		if (properties.AdminOnly && !utils.IsBotAdmin(cmd.UserId())) || (properties.HelperOnly && !utils.IsBotHelper(cmd.UserId())) {
+			continue
+		}
+
+		// Show slash commands only
+		if properties.Type != interaction.ApplicationCommandTypeChatInput {
+			continue
+		}
+
+		// only show commands the user has permissions for
+		if properties.PermissionLevel > permLevel {
+			continue
+		}
+
+		// Hide commands that the guild has disabled, or restricted to roles the user doesn't have
+		if !helpCommandPermitted(c, []string{properties.Name}, restrictions, member.Roles, permLevel) {
+			continue
+		}
+
+		for i, category := range command.Categories {
+			if category == properties.Category {
+				categorised[i] = append(categorised[i], c)
+				break
+			}
+		}
+	}
+
+	for _, categoryCommands := range categorised {
+		sort.Slice(categoryCommands, func(i, j int) bool {
+			return categoryCommands[i].Properties().Name < categoryCommands[j].Properties().Name
+		})
+	}
+
+	return categorised, nil
+}
+
+// helpCommandPermitted returns whether the restrictions on the command at the given path, and on its parents, allow
+// the member to run it, in the same way as when the command is executed. A command with subcommands is permitted if
+// any of its subcommands are, so that it is hidden once every subcommand has been disabled or restricted.
+func helpCommandPermitted(
+	c registry.Command,
+	path []string,
+	restrictions map[string]dbclient.CommandRestriction,
+	roles []uint64,
+	permLevel permission.PermissionLevel,
+) bool {
+	var applicable []dbclient.CommandRestriction
+	for _, key := range CommandRestrictionKeys(path) {
+		if restriction, ok := restrictions[key]; ok {
+			applicable = append(applicable, restriction)
+		}
+	}
+
+	if permitted, _ := CheckCommandRestrictions(applicable, roles, permLevel); !permitted {
+		return false
+	}
+
+	children := c.Properties().Children
+	if len(children) == 0 {
+		return true
+	}
+
+	for _, child := range children {
+		childPath := append(path[:len(path):len(path)], child.Properties().Name)
+		if helpCommandPermitted(child, childPath, restrictions, roles, permLevel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// helpMatchScore scores how well a command matches a search query, with 0 meaning no match. Names are matched
+// fuzzily, so that "clsreq" finds /closerequest, while descriptions must contain the query.
+func helpMatchScore(cmd registry.CommandContext, c registry.Command, query string) int {
+	query = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(query), "/")))
+	if query == "" {
+		return 0
+	}
+
+	properties := c.Properties()
+
+	score := fuzzyScore(query, properties.Name)
+	for _, alias := range properties.Aliases {
+		// Prefer a match on the real name over an equally good match on an alias
+		if aliasScore := fuzzyScore(query, alias) - 5; aliasScore > score {
+			score = aliasScore
+		}
+	}
+
+	if score == 0 && strings.Contains(strings.ToLower(cmd.GetMessage(properties.Description)), query) {
+		score = 10
+	}
+
+	return score
+}
+
+func fuzzyScore(query, target string) int {
+	target = strings.ToLower(target)
+
+	switch {
+	case target == query:
+		return 100
+	case strings.HasPrefix(target, query):
+		return 80
+	case strings.Contains(target, query):
+		return 60
+	case isSubsequence(query, target):
+		return 40
+	default:
+		return 0
+	}
+}
+
+func isSubsequence(query, target string) bool {
+	remaining := []rune(query)
+	for _, r := range target {
+		if len(remaining) == 0 {
+			break
+		}
+
+		if r == remaining[0] {
+			remaining = remaining[1:]
+		}
+	}
+
+	return len(remaining) == 0
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/statsd"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/channel/message"
@@ -119,6 +120,36 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 			cmd.HandleError(fmt.Errorf("invalid access control action %s", action))
 			return database.Ticket{}, err
 		}
+
+		allowed, err := checkAccessIntegration(ctx, cmd, *panel, formData)
+		if err != nil {
+			cmd.HandleError(err)
+			return database.Ticket{}, err
+		}
+
+		if !allowed {
+			return database.Ticket{}, nil
+		}
+	}
+
+	// Blacklisted users may only have one appeal open at a time
+	isAppeal, err := isBlacklistAppeal(ctx, cmd, panel)
+	if err != nil {
+		cmd.HandleError(err)
+		return database.Ticket{}, err
+	}
+
+	if isAppeal {
+		hasOpenAppeal, err := dbclient.Local.BlacklistAppeals.HasOpenAppeal(ctx, cmd.GuildId(), cmd.UserId())
+		if err != nil {
+			cmd.HandleError(err)
+			return database.Ticket{}, err
+		}
+
+		if hasOpenAppeal {
+			cmd.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklistAppealAlreadyOpen)
+			return database.Ticket{}, nil
+		}
 	}
 
 	settings, err := cmd.Settings()
@@ -225,6 +256,18 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		return database.Ticket{}, err
 	}
 
+	if isAppeal {
+		if err := dbclient.Local.BlacklistAppeals.Create(ctx, cmd.GuildId(), ticketId, cmd.UserId()); err != nil {
+			cmd.HandleError(err)
+			return database.Ticket{}, err
+		}
+	}
+
+	// Failing to store the search data shouldn't prevent the ticket from being opened
+	if err := storeTicketSearchData(ctx, cmd.GuildId(), ticketId, panel, subject, formData); err != nil {
+		fmt.Print(err, cmd.ToErrorContext())
+	}
+
 	unlocked = true
 	if _, err := mu.UnlockContext(ctx); err != nil && !errors.Is(err, redis.ErrLockExpired) {
 		cmd.HandleError(err)
@@ -353,6 +396,15 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		JoinMessageId:    joinMessageId,
 	}
 
+	if err := webhooks.Dispatch(ctx, cmd.GuildId(), ticketId, webhooks.EventOpened, cmd.UserId(), webhooks.OpenedData{
+		UserId:    cmd.UserId(),
+		ChannelId: &ch.Id,
+		PanelId:   panelId,
+		Subject:   subject,
+	}); err != nil {
+		fmt.Print(err, cmd.ToErrorContext())
+	}
+
 	// Welcome message
 	group.Go(func() error {
 
@@ -366,7 +418,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 			cmd.HandleError(err)
 		}
 
-		welcomeMessageId, err := SendWelcomeMessage(ctx, cmd, ticket, subject, panel, formData, additionalPlaceholders)
+		welcomeMessageId, err := SendWelcomeMessage(ctx, cmd, ticket, subject, panel, formData, additionalPlaceholders, isAppeal)
 		if err != nil {
 			return err
 		}
@@ -659,6 +711,37 @@ func createWebhook(ctx context.Context, c registry.CommandContext, ticketId int,
 	return nil
 }
 
+// storeTicketSearchData records the subject and form answers of a new ticket, which are otherwise only sent to Discord,
+// so that /tickets search can find the ticket after it is closed
+func storeTicketSearchData(ctx context.Context, guildId uint64, ticketId int, panel *database.Panel, subject string, formData map[database.FormInput]string) error {
+	// Panel titles are searched directly, so only the subject given to /open needs to be stored
+	if panel == nil {
+		if err := dbclient.Local.TicketSubjects.Set(ctx, guildId, ticketId, subject); err != nil {
+			return err
+		}
+	}
+
+	answers := make([]dbclient.TicketFormAnswer, 0, len(formData))
+	for input, answer := range formData {
+		if answer == "" {
+			continue
+		}
+
+		answers = append(answers, dbclient.TicketFormAnswer{
+			FormInputId: input.Id,
+			Position:    input.Position,
+			Question:    input.Label,
+			Answer:      answer,
+		})
+	}
+
+	if len(answers) == 0 {
+		return nil
+	}
+
+	return dbclient.Local.TicketFormAnswers.Create(ctx, guildId, ticketId, answers)
+}
+
 func CreateOverwrites(ctx context.Context, cmd registry.InteractionContext, userId uint64, panel *database.Panel, otherUsers ...uint64) ([]channel.PermissionOverwrite, error) {
 	overwrites := []channel.PermissionOverwrite{ // @everyone
 		{
@@ -863,6 +946,22 @@ func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel
 			name = fmt.Sprintf("%s-%d", strTicket, ticketId)
 		}
 	} else {
+		// Dates are in the guild's timezone, which is only looked up if the naming scheme contains a date
+		var now *time.Time
+		localNow := func() time.Time {
+			if now == nil {
+				location, err := GetGuildLocation(ctx, cmd.GuildId())
+				if err != nil {
+					fmt.Print(err, cmd.ToErrorContext())
+					location = time.UTC
+				}
+
+				now = utils.Ptr(time.Now().In(location))
+			}
+
+			return *now
+		}
+
 		var err error
 		name, err = doSubstitutions(cmd, *panel.NamingScheme, openerId, []Substitutor{
 			// %id%
@@ -894,6 +993,22 @@ func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel
 
 				return nickname
 			}),
+			// %date%
+			NewSubstitutor("date", false, false, func(user user.User, member member.Member) string {
+				return localNow().Format("2006-01-02")
+			}),
+			// %day%
+			NewSubstitutor("day", false, false, func(user user.User, member member.Member) string {
+				return localNow().Format("02")
+			}),
+			// %month%
+			NewSubstitutor("month", false, false, func(user user.User, member member.Member) string {
+				return localNow().Format("01")
+			}),
+			// %year%
+			NewSubstitutor("year", false, false, func(user user.User, member member.Member) string {
+				return localNow().Format("2006")
+			}),
 		})
 
 		if err != nil {
@@ -0,0 +1,268 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	worker "github.com/jadevelopmentgrp/Tickets-Worker"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations/linkedaccounts"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/templating"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"golang.org/x/sync/errgroup"
+)
+
+var placeholderPattern = regexp.MustCompile(`%([a-zA-Z0-9_]+)%`)
+
+// PlaceholderPreview describes the placeholders available to a message, and any problems with those it uses
+type PlaceholderPreview struct {
+	// Available lists every placeholder with its value for the ticket, sorted by name
+	Available []PlaceholderValue
+	// Unknown lists the placeholders used in the message that do not exist
+	Unknown []string
+	// Failing lists the placeholders used in the message whose value could not be looked up
+	Failing []PlaceholderFailure
+	// TemplateError is set if the message uses template syntax that does not parse
+	TemplateError error
+	// TemplatesDisabled is set if the message uses template syntax, but the guild has not enabled templates
+	TemplatesDisabled bool
+	Integrations      []IntegrationPreview
+	// AdditionalPlaceholders should be used when rendering the message, so that integrations are only called once
+	AdditionalPlaceholders map[string]string
+}
+
+type PlaceholderValue struct {
+	Name  string
+	Value string
+}
+
+type PlaceholderFailure struct {
+	Name   string
+	Reason string
+}
+
+type IntegrationPreview struct {
+	Name     string
+	Response string
+	Err      error
+}
+
+// GetPreviewTicket returns the ticket for the current channel. If this is not a ticket channel, a sample ticket opened
+// by the user is returned, numbered as the guild's next ticket would be, and false.
+func GetPreviewTicket(ctx context.Context, cmd registry.CommandContext) (database.Ticket, bool, error) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, cmd.ChannelId(), cmd.GuildId())
+	if err != nil {
+		return database.Ticket{}, false, err
+	}
+
+	if ticket.UserId != 0 {
+		return ticket, true, nil
+	}
+
+	count, err := dbclient.Analytics.GetTotalTicketCount(ctx, cmd.GuildId())
+	if err != nil {
+		return database.Ticket{}, false, err
+	}
+
+	channelId := cmd.ChannelId()
+	return database.Ticket{
+		Id:        int(count) + 1,
+		GuildId:   cmd.GuildId(),
+		ChannelId: &channelId,
+		UserId:    cmd.UserId(),
+		Open:      true,
+		OpenTime:  time.Now(),
+	}, false, nil
+}
+
+// GetWelcomeMessageSources returns the text of the welcome message that BuildWelcomeMessageEmbed would use for the
+// panel, in which placeholders may be used
+func GetWelcomeMessageSources(ctx context.Context, guildId uint64, panel *database.Panel) ([]string, error) {
+	if panel == nil || panel.WelcomeMessageEmbed == nil {
+		welcomeMessage, err := dbclient.Client.WelcomeMessages.Get(ctx, guildId)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{welcomeMessage}, nil
+	}
+
+	data, err := dbclient.Client.Embeds.GetEmbed(ctx, *panel.WelcomeMessageEmbed)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := dbclient.Client.EmbedFields.GetFieldsForEmbed(ctx, *panel.WelcomeMessageEmbed)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCustomEmbedSources(data, fields), nil
+}
+
+// GetCustomEmbedSources returns the parts of a custom embed in which placeholders are substituted
+func GetCustomEmbedSources(customEmbed database.CustomEmbed, fields []database.EmbedField) []string {
+	sources := []string{utils.ValueOrZero(customEmbed.Description)}
+	for _, field := range fields {
+		sources = append(sources, field.Value)
+	}
+
+	return sources
+}
+
+// PreviewPlaceholders looks up every placeholder for the ticket, and checks those used in the sources
+func PreviewPlaceholders(ctx context.Context, worker *worker.Context, ticket database.Ticket, sources []string) (PlaceholderPreview, error) {
+	var preview PlaceholderPreview
+
+	values := make(map[string]string)
+	failures := make(map[string]string)
+
+	var lock sync.Mutex
+	group, _ := errgroup.WithContext(ctx)
+
+	for placeholder, f := range substitutions {
+		placeholder := placeholder
+		f := f
+
+		group.Go(func() error {
+			ctx, cancel := context.WithTimeout(ctx, substitutionTimeout)
+			defer cancel()
+
+			value := f(ctx, worker, ticket)
+
+			lock.Lock()
+			values[placeholder] = value
+			lock.Unlock()
+
+			return nil
+		})
+	}
+
+	for _, provider := range integrations.LinkedAccounts.Providers() {
+		provider := provider
+
+		group.Go(func() error {
+			ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+			defer cancel()
+
+			replacements, err := provider.Lookup(ctx, ticket.UserId)
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			for _, placeholder := range provider.Placeholders() {
+				value, ok := replacements[placeholder]
+				if !ok {
+					value = "N/A"
+				}
+
+				values[placeholder] = value
+
+				if err != nil && !errors.Is(err, linkedaccounts.ErrNotLinked) {
+					failures[placeholder] = fmt.Sprintf("%s: %s", provider.Name(), err.Error())
+				}
+			}
+
+			return nil
+		})
+	}
+
+	var results []customIntegrationResult
+	group.Go(func() error {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+		defer cancel()
+
+		var err error
+		results, err = fetchCustomIntegrations(ctx, ticket, nil)
+		return err
+	})
+
+	if err := group.Wait(); err != nil {
+		return PlaceholderPreview{}, err
+	}
+
+	preview.AdditionalPlaceholders = make(map[string]string)
+	for _, result := range results {
+		for _, placeholder := range result.Placeholders {
+			value, ok := result.Values[placeholder.Name]
+			if !ok {
+				value = "N/A"
+			}
+
+			values[placeholder.Name] = value
+
+			if result.Err != nil {
+				failures[placeholder.Name] = fmt.Sprintf("%s: %s", result.Integration.Name, result.Err.Error())
+			} else {
+				preview.AdditionalPlaceholders[placeholder.Name] = value
+			}
+		}
+
+		preview.Integrations = append(preview.Integrations, IntegrationPreview{
+			Name:     result.Integration.Name,
+			Response: string(result.Response),
+			Err:      result.Err,
+		})
+	}
+
+	for name, value := range values {
+		preview.Available = append(preview.Available, PlaceholderValue{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	sort.Slice(preview.Available, func(i, j int) bool {
+		return preview.Available[i].Name < preview.Available[j].Name
+	})
+
+	// Check the placeholders used in the message
+	seen := make(map[string]bool)
+	usesTemplates := false
+	for _, source := range sources {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(source, -1) {
+			name := match[1]
+			if seen[name] {
+				continue
+			}
+
+			seen[name] = true
+
+			if _, ok := values[name]; !ok {
+				preview.Unknown = append(preview.Unknown, name)
+			} else if reason, ok := failures[name]; ok {
+				preview.Failing = append(preview.Failing, PlaceholderFailure{
+					Name:   name,
+					Reason: reason,
+				})
+			}
+		}
+
+		if templating.IsTemplate(source) {
+			usesTemplates = true
+
+			if _, err := templating.Parse(source); err != nil && preview.TemplateError == nil {
+				preview.TemplateError = err
+			}
+		}
+	}
+
+	if usesTemplates {
+		enabled, err := dbclient.Local.TemplatesEnabled.IsEnabled(ctx, ticket.GuildId)
+		if err != nil {
+			return PlaceholderPreview{}, err
+		}
+
+		preview.TemplatesDisabled = !enabled
+	}
+
+	return preview, nil
+}
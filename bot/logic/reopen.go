@@ -2,12 +2,14 @@ package logic
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/rest"
 	"github.com/rxdn/gdl/rest/request"
@@ -112,6 +114,10 @@ func ReopenTicket(ctx context.Context, cmd registry.CommandContext, ticketId int
 		return
 	}
 
+	if err := webhooks.Dispatch(ctx, ticket.GuildId, ticket.Id, webhooks.EventReopened, cmd.UserId(), nil); err != nil {
+		fmt.Print(err, cmd.ToErrorContext())
+	}
+
 	cmd.Reply(customisation.Green, i18n.Success, i18n.MessageReopenSuccess, ticket.Id, *ticket.ChannelId)
 
 	embedData := utils.BuildEmbed(cmd, customisation.Green, i18n.TitleReopened, i18n.MessageReopenedTicket, nil, cmd.UserId())
@@ -0,0 +1,702 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/guild/emoji"
+	"github.com/rxdn/gdl/objects/interaction/component"
+	"github.com/rxdn/gdl/permission"
+	"github.com/rxdn/gdl/rest"
+)
+
+const (
+	setupWizardStepMode = iota
+	setupWizardStepDestination
+	setupWizardStepTranscripts
+	setupWizardStepSupportRole
+	setupWizardStepAdminRole
+	setupWizardStepLimit
+	setupWizardStepLanguage
+	setupWizardStepPanel
+	setupWizardStepSummary
+)
+
+const (
+	SetupWizardCreateNew = "new"
+	SetupWizardNone      = "none"
+
+	// Select option values cannot be empty, so this is used in place of an empty language
+	setupWizardKeepLanguage = "current"
+
+	// Discord allows 25 options in a select menu. Channels, roles and languages are paged through when there are more
+	// than fit alongside the "create new" and "none" options.
+	setupWizardMaxOptions = 25
+)
+
+// SetupWizardState holds the choices made so far in /setup wizard. Nothing is applied until the wizard is confirmed on
+// the final step. Channels and roles are stored as IDs, or as SetupWizardCreateNew / SetupWizardNone.
+type SetupWizardState struct {
+	Step         int    `json:"step"`
+	Page         int    `json:"page"` // page of choices shown in the current step's select menu
+	UseThreads   bool   `json:"use_threads"`
+	Destination  string `json:"destination"` // ticket category, or notification channel if using threads
+	Transcripts  string `json:"transcripts"`
+	SupportRole  string `json:"support_role"`
+	AdminRole    string `json:"admin_role"`
+	TicketLimit  int    `json:"ticket_limit"`
+	Language     string `json:"language"` // empty to keep the current language
+	PanelChannel string `json:"panel_channel"`
+}
+
+func NewSetupWizardState() SetupWizardState {
+	return SetupWizardState{
+		Step:         setupWizardStepMode,
+		Destination:  SetupWizardCreateNew,
+		Transcripts:  SetupWizardCreateNew,
+		SupportRole:  SetupWizardCreateNew,
+		AdminRole:    SetupWizardCreateNew,
+		TicketLimit:  1,
+		PanelChannel: SetupWizardNone,
+	}
+}
+
+func (s *SetupWizardState) Next() {
+	if s.Step < setupWizardStepSummary {
+		s.Step++
+		s.Page = 0
+	}
+}
+
+func (s *SetupWizardState) Back() {
+	if s.Step > setupWizardStepMode {
+		s.Step--
+		s.Page = 0
+	}
+}
+
+// NextPage moves onto the next page of choices. The page is clamped when the message is built, as the number of
+// channels or roles may have changed since the page was shown.
+func (s *SetupWizardState) NextPage() {
+	s.Page++
+}
+
+func (s *SetupWizardState) PreviousPage() {
+	if s.Page > 0 {
+		s.Page--
+	}
+}
+
+func (s *SetupWizardState) IsSummary() bool {
+	return s.Step == setupWizardStepSummary
+}
+
+// SetMode chooses between channel and thread mode, and moves onto the next step. The destination is reset, as a
+// category is not a valid notification channel, and vice versa.
+func (s *SetupWizardState) SetMode(useThreads bool) {
+	if s.UseThreads != useThreads {
+		s.Destination = SetupWizardCreateNew
+	}
+
+	s.UseThreads = useThreads
+	s.Step = setupWizardStepDestination
+	s.Page = 0
+}
+
+// Select records the value chosen from the current step's select menu, returning false if the value is not valid
+func (s *SetupWizardState) Select(value string) bool {
+	switch s.Step {
+	case setupWizardStepDestination:
+		return setIfSnowflake(&s.Destination, value, SetupWizardCreateNew)
+	case setupWizardStepTranscripts:
+		return setIfSnowflake(&s.Transcripts, value, SetupWizardCreateNew, SetupWizardNone)
+	case setupWizardStepSupportRole:
+		return setIfSnowflake(&s.SupportRole, value, SetupWizardCreateNew)
+	case setupWizardStepAdminRole:
+		return setIfSnowflake(&s.AdminRole, value, SetupWizardCreateNew)
+	case setupWizardStepLimit:
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > 10 {
+			return false
+		}
+
+		s.TicketLimit = limit
+		return true
+	case setupWizardStepLanguage:
+		if value == setupWizardKeepLanguage {
+			value = ""
+		} else if _, ok := i18n.MappedByIsoShortCode[value]; !ok {
+			return false
+		}
+
+		s.Language = value
+		return true
+	case setupWizardStepPanel:
+		return setIfSnowflake(&s.PanelChannel, value, SetupWizardNone)
+	default:
+		return false
+	}
+}
+
+func setIfSnowflake(field *string, value string, allowed ...string) bool {
+	for _, allowedValue := range allowed {
+		if value == allowedValue {
+			*field = value
+			return true
+		}
+	}
+
+	if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+		return false
+	}
+
+	*field = value
+	return true
+}
+
+// BuildSetupWizardMessage renders the current step of the wizard. The token is that of the component state holding
+// the wizard state, which is attached to each component. If the page of choices is out of range, it is clamped.
+func BuildSetupWizardMessage(cmd registry.CommandContext, token string, s *SetupWizardState) (*embed.Embed, []component.Component, error) {
+	title, description := setupWizardStepText(s)
+
+	e := embed.NewEmbed().
+		SetColor(cmd.GetColour(customisation.Green)).
+		SetTitle(fmt.Sprintf("%s: %s", cmd.GetMessage(i18n.TitleSetup), cmd.GetMessage(title))).
+		SetDescription(cmd.GetMessage(description)).
+		SetFooter(cmd.GetMessage(i18n.SetupWizardStep, s.Step+1, setupWizardStepSummary+1), "https://avatars.githubusercontent.com/u/142818403")
+
+	var components []component.Component
+	switch s.Step {
+	case setupWizardStepMode:
+		components = append(components, component.BuildActionRow(
+			component.BuildButton(component.Button{
+				Label:    cmd.GetMessage(i18n.SetupWizardModeChannels),
+				CustomId: state.Attach("setupwizard_channels", token),
+				Style:    modeButtonStyle(!s.UseThreads),
+			}),
+			component.BuildButton(component.Button{
+				Label:    cmd.GetMessage(i18n.SetupWizardModeThreads),
+				CustomId: state.Attach("setupwizard_threads", token),
+				Style:    modeButtonStyle(s.UseThreads),
+			}),
+		))
+	case setupWizardStepSummary:
+		for _, field := range setupWizardSummaryFields(cmd, s) {
+			e.AddField(field.Name, field.Value, field.Inline)
+		}
+	default:
+		fixed, choices, err := setupWizardOptions(cmd, *s)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The fixed options, such as "create new", are shown on every page
+		pageSize := setupWizardMaxOptions - len(fixed)
+		pageCount := (len(choices) + pageSize - 1) / pageSize
+		if s.Page >= pageCount {
+			s.Page = max(pageCount-1, 0)
+		}
+
+		lower := s.Page * pageSize
+		upper := min(lower+pageSize, len(choices))
+		options := append(fixed, choices[lower:upper]...)
+
+		placeholder := cmd.GetMessage(i18n.SetupWizardSelect)
+		if pageCount > 1 {
+			placeholder = cmd.GetMessage(i18n.SetupWizardSelectPage, s.Page+1, pageCount)
+		}
+
+		components = append(components, component.BuildActionRow(component.BuildSelectMenu(component.SelectMenu{
+			CustomId:    state.Attach("setupwizard_select", token),
+			Options:     options,
+			Placeholder: utils.StringMax(placeholder, 150),
+		})))
+
+		if pageCount > 1 {
+			components = append(components, component.BuildActionRow(
+				component.BuildButton(component.Button{
+					CustomId: state.Attach("setupwizard_previouspage", token),
+					Style:    component.ButtonStyleSecondary,
+					Emoji:    &emoji.Emoji{Name: "◀️"},
+					Disabled: s.Page == 0,
+				}),
+				component.BuildButton(component.Button{
+					CustomId: state.Attach("setupwizard_nextpage", token),
+					Style:    component.ButtonStyleSecondary,
+					Emoji:    &emoji.Emoji{Name: "▶️"},
+					Disabled: s.Page >= pageCount-1,
+				}),
+			))
+		}
+	}
+
+	buttons := []component.Component{
+		component.BuildButton(component.Button{
+			Label:    cmd.GetMessage(i18n.SetupWizardBack),
+			CustomId: state.Attach("setupwizard_back", token),
+			Style:    component.ButtonStyleSecondary,
+			Disabled: s.Step == setupWizardStepMode,
+		}),
+	}
+
+	if s.IsSummary() {
+		buttons = append(buttons, component.BuildButton(component.Button{
+			Label:    cmd.GetMessage(i18n.SetupWizardConfirm),
+			CustomId: state.Attach("setupwizard_confirm", token),
+			Style:    component.ButtonStyleSuccess,
+		}))
+	} else if s.Step != setupWizardStepMode {
+		buttons = append(buttons, component.BuildButton(component.Button{
+			Label:    cmd.GetMessage(i18n.SetupWizardNext),
+			CustomId: state.Attach("setupwizard_next", token),
+			Style:    component.ButtonStylePrimary,
+		}))
+	}
+
+	buttons = append(buttons, component.BuildButton(component.Button{
+		Label:    cmd.GetMessage(i18n.SetupWizardCancel),
+		CustomId: state.Attach("setupwizard_cancel", token),
+		Style:    component.ButtonStyleDanger,
+	}))
+
+	components = append(components, component.BuildActionRow(buttons...))
+
+	return e, components, nil
+}
+
+func modeButtonStyle(selected bool) component.ButtonStyle {
+	if selected {
+		return component.ButtonStylePrimary
+	}
+
+	return component.ButtonStyleSecondary
+}
+
+func setupWizardStepText(s SetupWizardState) (title, description i18n.MessageId) {
+	switch s.Step {
+	case setupWizardStepMode:
+		return i18n.SetupWizardMode, i18n.SetupWizardModeDescription
+	case setupWizardStepDestination:
+		if s.UseThreads {
+			return i18n.SetupWizardNotificationChannel, i18n.SetupWizardNotificationChannelDescription
+		}
+
+		return i18n.SetupWizardCategory, i18n.SetupWizardCategoryDescription
+	case setupWizardStepTranscripts:
+		return i18n.SetupWizardTranscripts, i18n.SetupWizardTranscriptsDescription
+	case setupWizardStepSupportRole:
+		return i18n.SetupWizardSupportRole, i18n.SetupWizardSupportRoleDescription
+	case setupWizardStepAdminRole:
+		return i18n.SetupWizardAdminRole, i18n.SetupWizardAdminRoleDescription
+	case setupWizardStepLimit:
+		return i18n.SetupWizardLimit, i18n.SetupWizardLimitDescription
+	case setupWizardStepLanguage:
+		return i18n.SetupWizardLanguage, i18n.SetupWizardLanguageDescription
+	case setupWizardStepPanel:
+		return i18n.SetupWizardPanel, i18n.SetupWizardPanelDescription
+	default:
+		return i18n.SetupWizardSummary, i18n.SetupWizardSummaryDescription
+	}
+}
+
+// setupWizardOptions returns the options for the current step's select menu. The fixed options, such as "create new",
+// are shown on every page, while the choices are paged through.
+func setupWizardOptions(cmd registry.CommandContext, s SetupWizardState) (fixed, choices []component.SelectOption, err error) {
+	switch s.Step {
+	case setupWizardStepDestination:
+		channelType := channel.ChannelTypeGuildCategory
+		if s.UseThreads {
+			channelType = channel.ChannelTypeGuildText
+		}
+
+		fixed = append(fixed, setupWizardOption(cmd.GetMessage(i18n.SetupWizardCreateNew), SetupWizardCreateNew, s.Destination))
+
+		choices, err = setupWizardChannelOptions(cmd, channelType, s.Destination)
+		if err != nil {
+			return nil, nil, err
+		}
+	case setupWizardStepTranscripts, setupWizardStepPanel:
+		selected := s.Transcripts
+		if s.Step == setupWizardStepPanel {
+			selected = s.PanelChannel
+		} else {
+			fixed = append(fixed, setupWizardOption(cmd.GetMessage(i18n.SetupWizardCreateNew), SetupWizardCreateNew, selected))
+		}
+
+		fixed = append(fixed, setupWizardOption(cmd.GetMessage(i18n.SetupWizardNone), SetupWizardNone, selected))
+
+		choices, err = setupWizardChannelOptions(cmd, channel.ChannelTypeGuildText, selected)
+		if err != nil {
+			return nil, nil, err
+		}
+	case setupWizardStepSupportRole, setupWizardStepAdminRole:
+		selected := s.SupportRole
+		if s.Step == setupWizardStepAdminRole {
+			selected = s.AdminRole
+		}
+
+		fixed = append(fixed, setupWizardOption(cmd.GetMessage(i18n.SetupWizardCreateNew), SetupWizardCreateNew, selected))
+
+		roles, err := cmd.Worker().GetGuildRoles(cmd.GuildId())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sort.Slice(roles, func(i, j int) bool {
+			return roles[i].Position > roles[j].Position
+		})
+
+		for _, role := range roles {
+			// @everyone and roles managed by integrations cannot be used as staff roles
+			if role.Id == cmd.GuildId() || role.Managed {
+				continue
+			}
+
+			choices = append(choices, setupWizardOption(role.Name, strconv.FormatUint(role.Id, 10), selected))
+		}
+	case setupWizardStepLimit:
+		for i := 1; i <= 10; i++ {
+			choices = append(choices, setupWizardOption(strconv.Itoa(i), strconv.Itoa(i), strconv.Itoa(s.TicketLimit)))
+		}
+	case setupWizardStepLanguage:
+		selected := s.Language
+		if selected == "" {
+			selected = setupWizardKeepLanguage
+		}
+
+		fixed = append(fixed, setupWizardOption(cmd.GetMessage(i18n.SetupWizardKeepCurrent), setupWizardKeepLanguage, selected))
+
+		for _, locale := range i18n.Locales {
+			if locale.Coverage == 0 {
+				continue
+			}
+
+			option := setupWizardOption(locale.EnglishName, locale.IsoShortCode, selected)
+			option.Description = locale.LocalName
+			option.Emoji = utils.BuildEmoji(locale.FlagEmoji)
+			choices = append(choices, option)
+		}
+	}
+
+	return fixed, choices, nil
+}
+
+func setupWizardOption(label, value, selected string) component.SelectOption {
+	return component.SelectOption{
+		Label:   utils.StringMax(label, 100),
+		Value:   value,
+		Default: value == selected,
+	}
+}
+
+func setupWizardChannelOptions(cmd registry.CommandContext, channelType channel.ChannelType, selected string) ([]component.SelectOption, error) {
+	channels, err := cmd.Worker().GetGuildChannels(cmd.GuildId())
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Position < channels[j].Position
+	})
+
+	var options []component.SelectOption
+	for _, ch := range channels {
+		if ch.Type != channelType {
+			continue
+		}
+
+		label := ch.Name
+		if channelType == channel.ChannelTypeGuildText {
+			label = "#" + label
+		}
+
+		options = append(options, setupWizardOption(label, strconv.FormatUint(ch.Id, 10), selected))
+	}
+
+	return options, nil
+}
+
+func setupWizardSummaryFields(cmd registry.CommandContext, s SetupWizardState) []embed.EmbedField {
+	mode := cmd.GetMessage(i18n.SetupWizardModeChannels)
+	destinationTitle := i18n.SetupWizardCategory
+	if s.UseThreads {
+		mode = cmd.GetMessage(i18n.SetupWizardModeThreads)
+		destinationTitle = i18n.SetupWizardNotificationChannel
+	}
+
+	language := cmd.GetMessage(i18n.SetupWizardKeepCurrent)
+	if locale, ok := i18n.MappedByIsoShortCode[s.Language]; ok {
+		language = fmt.Sprintf("%s %s", locale.FlagEmoji, locale.EnglishName)
+	}
+
+	field := func(title i18n.MessageId, value string) embed.EmbedField {
+		return embed.EmbedField{
+			Name:   cmd.GetMessage(title),
+			Value:  value,
+			Inline: true,
+		}
+	}
+
+	return []embed.EmbedField{
+		field(i18n.SetupWizardMode, mode),
+		field(destinationTitle, formatSetupWizardChoice(cmd, s.Destination, "<#%s>")),
+		field(i18n.SetupWizardTranscripts, formatSetupWizardChoice(cmd, s.Transcripts, "<#%s>")),
+		field(i18n.SetupWizardSupportRole, formatSetupWizardChoice(cmd, s.SupportRole, "<@&%s>")),
+		field(i18n.SetupWizardAdminRole, formatSetupWizardChoice(cmd, s.AdminRole, "<@&%s>")),
+		field(i18n.SetupWizardLimit, strconv.Itoa(s.TicketLimit)),
+		field(i18n.SetupWizardLanguage, language),
+		field(i18n.SetupWizardPanel, formatSetupWizardChoice(cmd, s.PanelChannel, "<#%s>")),
+	}
+}
+
+func formatSetupWizardChoice(cmd registry.CommandContext, value, mentionFormat string) string {
+	switch value {
+	case SetupWizardCreateNew:
+		return cmd.GetMessage(i18n.SetupWizardCreateNew)
+	case SetupWizardNone:
+		return cmd.GetMessage(i18n.SetupWizardNone)
+	default:
+		return fmt.Sprintf(mentionFormat, value)
+	}
+}
+
+// CompleteSetupWizard applies the choices made in the wizard, creating any roles and channels that are needed. Failures
+// to create roles or channels, e.g. due to missing permissions, are reported in the returned summary rather than
+// aborting the remaining steps, as /setup auto does. Database errors are returned.
+func CompleteSetupWizard(ctx context.Context, cmd registry.CommandContext, s SetupWizardState) (string, error) {
+	guildId := cmd.GuildId()
+
+	var lines []string
+	report := func(step i18n.MessageId, ok bool) {
+		emoji := "✅"
+		if !ok {
+			emoji = "❌"
+		}
+
+		lines = append(lines, fmt.Sprintf("%s %s", emoji, cmd.GetMessage(step)))
+	}
+
+	// Roles first, so that any channels we create can be restricted to staff
+	supportRoleId, ok := resolveSetupWizardRole(cmd, s.SupportRole, "Tickets Support")
+	if ok {
+		if err := dbclient.Client.RolePermissions.AddSupport(ctx, guildId, supportRoleId); err != nil {
+			return "", err
+		}
+	}
+
+	report(i18n.SetupWizardSupportRole, ok)
+
+	adminRoleId, ok := resolveSetupWizardRole(cmd, s.AdminRole, "Tickets Admin")
+	if ok {
+		if err := dbclient.Client.RolePermissions.AddAdmin(ctx, guildId, adminRoleId); err != nil {
+			return "", err
+		}
+	}
+
+	report(i18n.SetupWizardAdminRole, ok)
+
+	var categoryId uint64
+	if s.UseThreads {
+		channelId, ok := resolveSetupWizardChannel(cmd, s.Destination, PrivateStaffChannelData("ticket-notifications", guildId, supportRoleId, adminRoleId))
+		if ok {
+			if err := dbclient.Client.Settings.EnableThreads(ctx, guildId, channelId); err != nil {
+				return "", err
+			}
+		}
+
+		report(i18n.SetupWizardNotificationChannel, ok)
+	} else {
+		categoryData := rest.CreateChannelData{
+			Name: "Tickets",
+			Type: channel.ChannelTypeGuildCategory,
+		}
+
+		categoryId, ok = resolveSetupWizardChannel(cmd, s.Destination, categoryData)
+		if ok {
+			if err := dbclient.Client.ChannelCategory.Set(ctx, guildId, categoryId); err != nil {
+				return "", err
+			}
+
+			if err := dbclient.Client.Settings.DisableThreads(ctx, guildId); err != nil {
+				return "", err
+			}
+		}
+
+		report(i18n.SetupWizardCategory, ok)
+	}
+
+	if s.Transcripts == SetupWizardNone {
+		if err := dbclient.Client.ArchiveChannel.Set(ctx, guildId, nil); err != nil {
+			return "", err
+		}
+	} else {
+		channelId, ok := resolveSetupWizardChannel(cmd, s.Transcripts, PrivateStaffChannelData("transcripts", guildId, supportRoleId, adminRoleId))
+		if ok {
+			if err := dbclient.Client.ArchiveChannel.Set(ctx, guildId, utils.Ptr(channelId)); err != nil {
+				return "", err
+			}
+		}
+
+		report(i18n.SetupWizardTranscripts, ok)
+	}
+
+	if err := dbclient.Client.TicketLimit.Set(ctx, guildId, uint8(s.TicketLimit)); err != nil {
+		return "", err
+	}
+
+	if s.Language != "" {
+		if err := dbclient.Client.ActiveLanguage.Set(ctx, guildId, s.Language); err != nil {
+			return "", err
+		}
+	}
+
+	if s.PanelChannel != SetupWizardNone {
+		ok, err := createSetupWizardPanel(ctx, cmd, s.PanelChannel, categoryId)
+		if err != nil {
+			return "", err
+		}
+
+		report(i18n.SetupWizardPanel, ok)
+	}
+
+	lines = append(lines, "", cmd.GetMessage(i18n.SetupWizardCompleted), cmd.GetMessage(i18n.SetupAutoDocs))
+	return strings.Join(lines, "\n"), nil
+}
+
+// resolveSetupWizardRole returns the ID of the chosen role, creating it if necessary. ok is false if the role could not
+// be created.
+func resolveSetupWizardRole(cmd registry.CommandContext, choice, name string) (uint64, bool) {
+	if choice != SetupWizardCreateNew {
+		id, err := strconv.ParseUint(choice, 10, 64)
+		return id, err == nil
+	}
+
+	role, err := cmd.Worker().CreateGuildRole(cmd.GuildId(), rest.GuildRoleData{Name: name})
+	if err != nil {
+		return 0, false
+	}
+
+	return role.Id, true
+}
+
+// resolveSetupWizardChannel returns the ID of the chosen channel, creating it if necessary. ok is false if the channel
+// could not be created.
+func resolveSetupWizardChannel(cmd registry.CommandContext, choice string, data rest.CreateChannelData) (uint64, bool) {
+	if choice != SetupWizardCreateNew {
+		id, err := strconv.ParseUint(choice, 10, 64)
+		return id, err == nil
+	}
+
+	ch, err := cmd.Worker().CreateGuildChannel(cmd.GuildId(), data)
+	if err != nil {
+		return 0, false
+	}
+
+	return ch.Id, true
+}
+
+func createSetupWizardPanel(ctx context.Context, cmd registry.CommandContext, channelChoice string, categoryId uint64) (bool, error) {
+	channelId, err := strconv.ParseUint(channelChoice, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+
+	colour, err := customisation.GetColour(ctx, cmd.GuildId(), customisation.Green)
+	if err != nil {
+		return false, err
+	}
+
+	panel := database.Panel{
+		ChannelId:       channelId,
+		GuildId:         cmd.GuildId(),
+		Title:           cmd.GetMessage(i18n.SetupWizardPanelTitle),
+		Content:         cmd.GetMessage(i18n.SetupWizardPanelContent),
+		Colour:          int32(colour),
+		TargetCategory:  categoryId,
+		WithDefaultTeam: true,
+		CustomId:        utils.RandString(30),
+		ButtonStyle:     int(component.ButtonStylePrimary),
+		ButtonLabel:     cmd.GetMessage(i18n.SetupWizardPanelButton),
+	}
+
+	e := embed.NewEmbed().
+		SetColor(colour).
+		SetTitle(panel.Title).
+		SetDescription(panel.Content)
+
+	msg, err := cmd.Worker().CreateMessageComplex(channelId, rest.CreateMessageData{
+		Embeds: utils.Slice(e),
+		Components: utils.Slice(component.BuildActionRow(component.BuildButton(component.Button{
+			Label:    panel.ButtonLabel,
+			CustomId: panel.CustomId,
+			Style:    component.ButtonStylePrimary,
+		}))),
+	})
+	if err != nil {
+		return false, nil
+	}
+
+	panel.MessageId = msg.Id
+
+	if _, err := dbclient.Client.Panel.Create(ctx, panel); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// PrivateStaffChannelData builds a text channel that only the given staff roles can see
+func PrivateStaffChannelData(name string, guildId, supportRoleId, adminRoleId uint64) rest.CreateChannelData {
+	allow := permission.BuildPermissions(
+		permission.ViewChannel,
+		permission.SendMessages,
+		permission.EmbedLinks,
+		permission.AttachFiles,
+		permission.ReadMessageHistory,
+	)
+
+	overwrites := []channel.PermissionOverwrite{
+		{ // deny everyone else access to channel
+			Id:    guildId,
+			Type:  channel.PermissionTypeRole,
+			Allow: 0,
+			Deny:  allow,
+		},
+	}
+
+	if supportRoleId != 0 {
+		overwrites = append(overwrites, channel.PermissionOverwrite{
+			Id:    supportRoleId,
+			Type:  channel.PermissionTypeRole,
+			Allow: allow,
+			Deny:  0,
+		})
+	}
+
+	if adminRoleId != 0 {
+		overwrites = append(overwrites, channel.PermissionOverwrite{
+			Id:    adminRoleId,
+			Type:  channel.PermissionTypeRole,
+			Allow: allow,
+			Deny:  0,
+		})
+	}
+
+	return rest.CreateChannelData{
+		Name:                 name,
+		Type:                 channel.ChannelTypeGuildText,
+		PermissionOverwrites: overwrites,
+	}
+}
@@ -0,0 +1,261 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	worker "github.com/jadevelopmentgrp/Tickets-Worker"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations/linkedaccounts"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/templating"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+)
+
+// escapedPercent stands in for % in user-supplied text output by a template, such as form answers, so that the
+// %placeholder% pass that runs after the template does not substitute placeholders typed by the user. It is a private
+// use character, so will not otherwise appear in messages.
+const escapedPercent = "\uE025"
+
+func escapePlaceholders(s string) string {
+	return strings.ReplaceAll(s, "%", escapedPercent)
+}
+
+func unescapePlaceholders(s string) string {
+	return strings.ReplaceAll(s, escapedPercent, "%")
+}
+
+// templateData exposes the placeholders to templates. Placeholders are methods rather than fields, so that only those
+// used by the template are looked up, and each is looked up at most once. Templates may only range over FormAnswers, so it must remain a
+// slice; Now and Opened are only for the date and timestamp functions.
+type templateData struct {
+	ctx                    context.Context
+	worker                 *worker.Context
+	ticket                 database.Ticket
+	additionalPlaceholders map[string]string
+
+	// Now is the time the template is rendered, and Opened the time the ticket was opened, in the guild's timezone
+	Now    time.Time
+	Opened time.Time
+
+	values        map[string]string
+	linkedAccount map[string]map[string]string // provider name -> values
+	formAnswers   []TemplateFormAnswer         // nil until first used
+}
+
+type TemplateFormAnswer struct {
+	Question string
+	Answer   string
+}
+
+func newTemplateData(ctx context.Context, worker *worker.Context, ticket database.Ticket, additionalPlaceholders map[string]string) *templateData {
+	// Times are in the guild's timezone, so that the date function formats them as the guild expects
+	location, err := GetGuildLocation(ctx, ticket.GuildId)
+	if err != nil {
+		fmt.Print(err)
+		location = time.UTC
+	}
+
+	return &templateData{
+		ctx:                    ctx,
+		worker:                 worker,
+		ticket:                 ticket,
+		additionalPlaceholders: additionalPlaceholders,
+		Now:                    time.Now().In(location),
+		Opened:                 ticket.OpenTime.In(location),
+		values:                 make(map[string]string),
+		linkedAccount:          make(map[string]map[string]string),
+	}
+}
+
+// renderTemplate renders the template syntax if the guild has opted in to it. The text is returned unchanged if the
+// guild has not, or if the template is invalid.
+func renderTemplate(ctx context.Context, message string, worker *worker.Context, ticket database.Ticket, additionalPlaceholders map[string]string) string {
+	if !templating.IsTemplate(message) {
+		return message
+	}
+
+	errorContext := errorcontext.WorkerErrorContext{Guild: ticket.GuildId, User: ticket.UserId}
+
+	enabled, err := dbclient.Local.TemplatesEnabled.IsEnabled(ctx, ticket.GuildId)
+	if err != nil {
+		fmt.Print(err, errorContext)
+		return message
+	}
+
+	if !enabled {
+		return message
+	}
+
+	// Allow as long as the linked account lookups may take
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	rendered, err := templating.Render(ctx, message, newTemplateData(ctx, worker, ticket, additionalPlaceholders))
+	if err != nil {
+		fmt.Print(fmt.Errorf("failed to render template: %w", err), errorContext)
+		return message
+	}
+
+	return rendered
+}
+
+func (d *templateData) substitute(name string) string {
+	if value, ok := d.values[name]; ok {
+		return value
+	}
+
+	value := substitutions[name](d.ctx, d.worker, d.ticket)
+	d.values[name] = value
+	return value
+}
+
+func (d *templateData) User() string {
+	return d.substitute("user")
+}
+
+func (d *templateData) TicketId() string {
+	return d.substitute("ticket_id")
+}
+
+func (d *templateData) Channel() string {
+	return d.substitute("channel")
+}
+
+func (d *templateData) Username() string {
+	return d.substitute("username")
+}
+
+func (d *templateData) Server() string {
+	return d.substitute("server")
+}
+
+func (d *templateData) OpenTickets() string {
+	return d.substitute("open_tickets")
+}
+
+func (d *templateData) TotalTickets() string {
+	return d.substitute("total_tickets")
+}
+
+func (d *templateData) UserOpenTickets() string {
+	return d.substitute("user_open_tickets")
+}
+
+func (d *templateData) UserTotalTickets() string {
+	return d.substitute("user_total_tickets")
+}
+
+func (d *templateData) TicketLimit() string {
+	return d.substitute("ticket_limit")
+}
+
+func (d *templateData) RatingCount() string {
+	return d.substitute("rating_count")
+}
+
+func (d *templateData) AverageRating() string {
+	return d.substitute("average_rating")
+}
+
+func (d *templateData) FirstResponseTimeWeekly() string {
+	return d.substitute("first_response_time_weekly")
+}
+
+func (d *templateData) FirstResponseTimeMonthly() string {
+	return d.substitute("first_response_time_monthly")
+}
+
+func (d *templateData) FirstResponseTimeAllTime() string {
+	return d.substitute("first_response_time_all_time")
+}
+
+func (d *templateData) DiscordAccountCreationDate() string {
+	return d.substitute("discord_account_creation_date")
+}
+
+func (d *templateData) DiscordAccountAge() string {
+	return d.substitute("discord_account_age")
+}
+
+// Claimer is a mention of the staff member that claimed the ticket, or empty if it is unclaimed
+func (d *templateData) Claimer() string {
+	if value, ok := d.values["claimer"]; ok {
+		return value
+	}
+
+	claimer, err := dbclient.Client.TicketClaims.Get(d.ctx, d.ticket.GuildId, d.ticket.Id)
+	if err != nil {
+		fmt.Print(err)
+	}
+
+	var value string
+	if claimer != 0 {
+		value = fmt.Sprintf("<@%d>", claimer)
+	}
+
+	d.values["claimer"] = value
+	return value
+}
+
+// FormAnswers are the answers given to the panel's form when the ticket was opened, excluding unanswered questions.
+// The answers are looked up once per render, however many times the template ranges over them.
+func (d *templateData) FormAnswers() []TemplateFormAnswer {
+	if d.formAnswers != nil {
+		return d.formAnswers
+	}
+
+	answers, err := dbclient.Local.TicketFormAnswers.GetByTicket(d.ctx, d.ticket.GuildId, d.ticket.Id)
+	if err != nil {
+		fmt.Print(err)
+		return nil
+	}
+
+	d.formAnswers = make([]TemplateFormAnswer, len(answers))
+	for i, answer := range answers {
+		d.formAnswers[i] = TemplateFormAnswer{
+			Question: escapePlaceholders(answer.Question),
+			Answer:   escapePlaceholders(answer.Answer),
+		}
+	}
+
+	return d.formAnswers
+}
+
+// Placeholder returns the value of any placeholder by its %placeholder% name, including custom integration and linked
+// account placeholders, or empty if it has no value
+func (d *templateData) Placeholder(name string) string {
+	if value, ok := d.additionalPlaceholders[name]; ok {
+		return value
+	}
+
+	if _, ok := substitutions[name]; ok {
+		return d.substitute(name)
+	}
+
+	for _, provider := range integrations.LinkedAccounts.Providers() {
+		if !utils.Contains(provider.Placeholders(), name) {
+			continue
+		}
+
+		values, ok := d.linkedAccount[provider.Name()]
+		if !ok {
+			var err error
+			values, err = provider.Lookup(d.ctx, d.ticket.UserId)
+			if err != nil && !errors.Is(err, linkedaccounts.ErrNotLinked) {
+				fmt.Print(err)
+			}
+
+			d.linkedAccount[provider.Name()] = values
+		}
+
+		return values[name]
+	}
+
+	return ""
+}
@@ -0,0 +1,253 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/interaction/component"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	ticketHistoryPageSize = 5
+
+	// TicketHistoryAllPanels is the value of the panel select menu option that removes the panel filter
+	TicketHistoryAllPanels = "all"
+)
+
+// TicketHistoryState is kept in the component state store, so that the page buttons and panel select menu can
+// re-render the history
+type TicketHistoryState struct {
+	UserId   uint64 `json:"user_id"`
+	TargetId uint64 `json:"target_id"`
+	PanelId  *int   `json:"panel_id,omitempty"`
+	Page     int    `json:"page"`
+}
+
+type ticketHistoryDetails struct {
+	claimedBy uint64
+	reason    *string
+	rating    *dbclient.FeedbackRating
+}
+
+// BuildTicketHistoryMessage builds a page of the tickets the target user has opened in the guild, newest first
+func BuildTicketHistoryMessage(ctx context.Context, cmd registry.CommandContext, token string, s TicketHistoryState) (*embed.Embed, []component.Component, error) {
+	var tickets []database.Ticket
+	var panels []database.Panel
+
+	group, _ := errgroup.WithContext(ctx)
+
+	group.Go(func() (err error) {
+		tickets, err = dbclient.Client.Tickets.GetAllByUser(ctx, cmd.GuildId(), s.TargetId)
+		return
+	})
+
+	group.Go(func() (err error) {
+		panels, err = dbclient.Client.Panel.GetByGuild(ctx, cmd.GuildId())
+		return
+	})
+
+	if err := group.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(tickets, func(i, j int) bool {
+		return tickets[i].Id > tickets[j].Id
+	})
+
+	panelTitles := make(map[int]string)
+	for _, panel := range panels {
+		panelTitles[panel.PanelId] = panel.Title
+	}
+
+	filtered := make([]database.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		if s.PanelId == nil || (ticket.PanelId != nil && *ticket.PanelId == *s.PanelId) {
+			filtered = append(filtered, ticket)
+		}
+	}
+
+	e := embed.NewEmbed().
+		SetColor(cmd.GetColour(customisation.Green)).
+		SetTitle(cmd.GetMessage(i18n.TitleTicketHistory)).
+		SetDescription(cmd.GetMessage(i18n.MessageTicketHistoryDescription, s.TargetId, len(filtered)))
+
+	pageCount := (len(filtered) + ticketHistoryPageSize - 1) / ticketHistoryPageSize
+	if pageCount == 0 {
+		pageCount = 1
+	}
+
+	page := s.Page
+	if page < 0 || page >= pageCount {
+		page = 0
+	}
+
+	lower := page * ticketHistoryPageSize
+	upper := lower + ticketHistoryPageSize
+	if upper > len(filtered) {
+		upper = len(filtered)
+	}
+
+	pageTickets := filtered[lower:upper]
+
+	details, err := getTicketHistoryDetails(ctx, pageTickets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, ticket := range pageTickets {
+		name := fmt.Sprintf("#%d", ticket.Id)
+		if ticket.PanelId != nil {
+			if title, ok := panelTitles[*ticket.PanelId]; ok {
+				name = fmt.Sprintf("#%d • %s", ticket.Id, title)
+			}
+		}
+
+		e.AddField(name, formatTicketHistoryEntry(cmd, ticket, details[i]), false)
+	}
+
+	e.SetFooter(cmd.GetMessage(i18n.MessageTicketHistoryPage, page+1, pageCount), "")
+
+	components := []component.Component{
+		buildTicketHistoryPanelSelect(cmd, token, tickets, panelTitles, s.PanelId),
+		component.BuildActionRow(
+			component.BuildButton(component.Button{
+				CustomId: state.Attach(fmt.Sprintf("tickethistory_page_%d", page-1), token),
+				Style:    component.ButtonStyleSecondary,
+				Emoji:    utils.BuildEmoji("◀️"),
+				Disabled: page <= 0,
+			}),
+			component.BuildButton(component.Button{
+				CustomId: state.Attach(fmt.Sprintf("tickethistory_page_%d", page+1), token),
+				Style:    component.ButtonStyleSecondary,
+				Emoji:    utils.BuildEmoji("▶️"),
+				Disabled: page >= pageCount-1,
+			}),
+		),
+	}
+
+	return e, components, nil
+}
+
+func formatTicketHistoryEntry(cmd registry.CommandContext, ticket database.Ticket, details ticketHistoryDetails) string {
+	lines := []string{
+		cmd.GetMessage(i18n.MessageTicketHistoryOpened, message.BuildTimestamp(ticket.OpenTime, message.TimestampStyleShortDateTime)),
+	}
+
+	if ticket.Open {
+		if ticket.ChannelId != nil {
+			lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryStillOpen, *ticket.ChannelId))
+		}
+	} else if ticket.CloseTime != nil {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryClosed, message.BuildTimestamp(*ticket.CloseTime, message.TimestampStyleShortDateTime)))
+	}
+
+	if details.reason != nil {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryReason, utils.EscapeMarkdown(*details.reason)))
+	}
+
+	if details.claimedBy != 0 {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryClaimedBy, details.claimedBy))
+	}
+
+	if details.rating != nil {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryRating, FormatRating(details.rating.Scale, details.rating.Score)))
+	}
+
+	if ticket.HasTranscript {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryTranscript, TranscriptUrl(ticket.GuildId, ticket.Id)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// buildTicketHistoryPanelSelect offers the panels that the user has opened tickets from
+func buildTicketHistoryPanelSelect(cmd registry.CommandContext, token string, tickets []database.Ticket, panelTitles map[int]string, selected *int) component.Component {
+	options := []component.SelectOption{
+		{
+			Label:   cmd.GetMessage(i18n.MessageTicketHistoryAllPanels),
+			Value:   TicketHistoryAllPanels,
+			Default: selected == nil,
+		},
+	}
+
+	seen := make(map[int]bool)
+	for _, ticket := range tickets {
+		// Leave room for the "all panels" option
+		if len(options) == 25 {
+			break
+		}
+
+		if ticket.PanelId == nil || seen[*ticket.PanelId] {
+			continue
+		}
+
+		title, ok := panelTitles[*ticket.PanelId]
+		if !ok {
+			continue
+		}
+
+		seen[*ticket.PanelId] = true
+		options = append(options, component.SelectOption{
+			Label:   title,
+			Value:   strconv.Itoa(*ticket.PanelId),
+			Default: selected != nil && *selected == *ticket.PanelId,
+		})
+	}
+
+	return component.BuildActionRow(component.BuildSelectMenu(component.SelectMenu{
+		CustomId:    state.Attach("tickethistory_panel", token),
+		Options:     options,
+		Placeholder: cmd.GetMessage(i18n.MessageTicketHistorySelectPanel),
+	}))
+}
+
+// getTicketHistoryDetails looks up the claimer, close reason and rating of each ticket
+func getTicketHistoryDetails(ctx context.Context, tickets []database.Ticket) ([]ticketHistoryDetails, error) {
+	details := make([]ticketHistoryDetails, len(tickets))
+
+	group, _ := errgroup.WithContext(ctx)
+
+	for i, ticket := range tickets {
+		group.Go(func() (err error) {
+			details[i].claimedBy, err = dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+			return
+		})
+
+		group.Go(func() error {
+			closeMetadata, ok, err := dbclient.Client.CloseReason.Get(ctx, ticket.GuildId, ticket.Id)
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				details[i].reason = closeMetadata.Reason
+			}
+
+			return nil
+		})
+
+		group.Go(func() (err error) {
+			details[i].rating, err = GetFeedbackRating(ctx, ticket.GuildId, ticket.Id)
+			return
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
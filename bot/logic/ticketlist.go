@@ -0,0 +1,205 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/model"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+// Each ticket on the page gets an action row, leaving the last row for the page buttons
+const ticketListPageSize = 4
+
+// TicketListFilter narrows down the open tickets shown by /tickets list. Nil fields are not filtered on.
+type TicketListFilter struct {
+	Unclaimed bool                `json:"unclaimed,omitempty"`
+	ClaimerId *uint64             `json:"claimer_id,omitempty"`
+	PanelId   *int                `json:"panel_id,omitempty"`
+	Status    *model.TicketStatus `json:"status,omitempty"`
+	OlderThan *time.Duration      `json:"older_than,omitempty"`
+	OpenerId  *uint64             `json:"opener_id,omitempty"`
+}
+
+// TicketListState is kept in the component state store, so that the page and action buttons can re-render the list
+type TicketListState struct {
+	UserId uint64           `json:"user_id"`
+	Filter TicketListFilter `json:"filter"`
+	Page   int              `json:"page"`
+}
+
+func (f TicketListFilter) needsClaims() bool {
+	return f.Unclaimed || f.ClaimerId != nil
+}
+
+func (f TicketListFilter) matches(ticket database.Ticket, claimedBy uint64) bool {
+	if ticket.ChannelId == nil {
+		return false
+	}
+
+	if f.Unclaimed && claimedBy != 0 {
+		return false
+	}
+
+	if f.ClaimerId != nil && claimedBy != *f.ClaimerId {
+		return false
+	}
+
+	if f.PanelId != nil && (ticket.PanelId == nil || *ticket.PanelId != *f.PanelId) {
+		return false
+	}
+
+	if f.Status != nil && ticket.Status != *f.Status {
+		return false
+	}
+
+	if f.OlderThan != nil && time.Since(ticket.OpenTime) < *f.OlderThan {
+		return false
+	}
+
+	if f.OpenerId != nil && ticket.UserId != *f.OpenerId {
+		return false
+	}
+
+	return true
+}
+
+// BuildTicketListMessage builds a page of the open tickets in the guild that match the filter, oldest first, with
+// quick actions for each ticket
+func BuildTicketListMessage(ctx context.Context, cmd registry.CommandContext, token string, s TicketListState) (*embed.Embed, []component.Component, error) {
+	tickets, err := dbclient.Client.Tickets.GetGuildOpenTickets(ctx, cmd.GuildId())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(tickets, func(i, j int) bool {
+		return tickets[i].OpenTime.Before(tickets[j].OpenTime)
+	})
+
+	// Only look up the claims of every ticket if we need to filter on them, otherwise just those on the page
+	var claims map[int]uint64
+	if s.Filter.needsClaims() {
+		if claims, err = getTicketClaims(ctx, cmd.GuildId(), tickets); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	filtered := make([]database.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		if s.Filter.matches(ticket, claims[ticket.Id]) {
+			filtered = append(filtered, ticket)
+		}
+	}
+
+	e := embed.NewEmbed().
+		SetColor(cmd.GetColour(customisation.Green)).
+		SetTitle(cmd.GetMessage(i18n.TitleTicketList))
+
+	// The page buttons are kept even if there are no tickets left, as edits are unable to remove all components
+	if len(filtered) == 0 {
+		e.SetDescription(cmd.GetMessage(i18n.MessageTicketListEmpty))
+	}
+
+	pageCount := (len(filtered) + ticketListPageSize - 1) / ticketListPageSize
+	if pageCount == 0 {
+		pageCount = 1
+	}
+
+	page := s.Page
+	if page < 0 || page >= pageCount {
+		page = 0
+	}
+
+	lower := page * ticketListPageSize
+	upper := lower + ticketListPageSize
+	if upper > len(filtered) {
+		upper = len(filtered)
+	}
+
+	pageTickets := filtered[lower:upper]
+	if claims == nil {
+		if claims, err = getTicketClaims(ctx, cmd.GuildId(), pageTickets); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	components := make([]component.Component, 0, len(pageTickets)+1)
+	for _, ticket := range pageTickets {
+		claimedBy := claims[ticket.Id]
+
+		claimed := cmd.GetMessage(i18n.MessageTicketListUnclaimed)
+		if claimedBy != 0 {
+			claimed = cmd.GetMessage(i18n.MessageTicketListClaimedBy, claimedBy)
+		}
+
+		status := cmd.GetMessage(i18n.MessageTicketListStatusOpen)
+		if ticket.Status == model.TicketStatusPending {
+			status = cmd.GetMessage(i18n.MessageTicketListStatusPending)
+		}
+
+		value := cmd.GetMessage(i18n.MessageTicketListEntry,
+			*ticket.ChannelId, ticket.UserId, message.BuildTimestamp(ticket.OpenTime, message.TimestampStyleRelativeTime), claimed, status)
+		e.AddField(fmt.Sprintf("#%d", ticket.Id), value, false)
+
+		components = append(components, component.BuildActionRow(
+			component.BuildButton(component.Button{
+				Label: fmt.Sprintf("#%d", ticket.Id),
+				Style: component.ButtonStyleLink,
+				Url:   utils.Ptr(fmt.Sprintf("https://discord.com/channels/%d/%d", ticket.GuildId, *ticket.ChannelId)),
+			}),
+			component.BuildButton(component.Button{
+				Label:    cmd.GetMessage(i18n.TitleClaim),
+				CustomId: state.Attach(fmt.Sprintf("ticketlist_claim_%d", ticket.Id), token),
+				Style:    component.ButtonStyleSuccess,
+				Emoji:    utils.BuildEmoji("🙋‍♂️"),
+				Disabled: claimedBy != 0 || ticket.IsThread,
+			}),
+			component.BuildButton(component.Button{
+				Label:    cmd.GetMessage(i18n.TitleClose),
+				CustomId: state.Attach(fmt.Sprintf("ticketlist_close_%d", ticket.Id), token),
+				Style:    component.ButtonStyleDanger,
+				Emoji:    utils.BuildEmoji("🔒"),
+			}),
+		))
+	}
+
+	e.SetFooter(cmd.GetMessage(i18n.MessageTicketListPage, page+1, pageCount, len(filtered)), "")
+
+	components = append(components, component.BuildActionRow(
+		component.BuildButton(component.Button{
+			CustomId: state.Attach(fmt.Sprintf("ticketlist_page_%d", page-1), token),
+			Style:    component.ButtonStyleSecondary,
+			Emoji:    utils.BuildEmoji("◀️"),
+			Disabled: page <= 0,
+		}),
+		component.BuildButton(component.Button{
+			CustomId: state.Attach(fmt.Sprintf("ticketlist_page_%d", page+1), token),
+			Style:    component.ButtonStyleSecondary,
+			Emoji:    utils.BuildEmoji("▶️"),
+			Disabled: page >= pageCount-1,
+		}),
+	))
+
+	return e, components, nil
+}
+
+// getTicketClaims returns the user who claimed each ticket, omitting unclaimed tickets
+func getTicketClaims(ctx context.Context, guildId uint64, tickets []database.Ticket) (map[int]uint64, error) {
+	ticketIds := make([]int, len(tickets))
+	for i, ticket := range tickets {
+		ticketIds[i] = ticket.Id
+	}
+
+	return dbclient.Local.TicketClaimLookup.GetAll(ctx, guildId, ticketIds)
+}
@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/state"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+const ticketSearchPageSize = 5
+
+// TicketSearchState is kept in the component state store, so that the page buttons can re-run the search. The store
+// only lets the searcher use the state, as the results may include tickets from channels other staff can't see.
+type TicketSearchState struct {
+	Query   string     `json:"query"`
+	After   *time.Time `json:"after,omitempty"`
+	Before  *time.Time `json:"before,omitempty"`
+	StaffId *uint64    `json:"staff_id,omitempty"`
+	Page    int        `json:"page"`
+}
+
+// BuildTicketSearchMessage builds a page of the guild's closed tickets that match the search
+func BuildTicketSearchMessage(ctx context.Context, cmd registry.CommandContext, token string, s TicketSearchState) (*embed.Embed, []component.Component, error) {
+	if s.Page < 0 {
+		s.Page = 0
+	}
+
+	results, total, err := dbclient.Local.TicketSearch.Search(ctx, cmd.GuildId(), dbclient.TicketSearchOptions{
+		Query:   s.Query,
+		After:   s.After,
+		Before:  s.Before,
+		StaffId: s.StaffId,
+		Limit:   ticketSearchPageSize,
+		Offset:  s.Page * ticketSearchPageSize,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := embed.NewEmbed().
+		SetColor(cmd.GetColour(customisation.Green)).
+		SetTitle(cmd.GetMessage(i18n.TitleTicketSearch)).
+		SetDescription(cmd.GetMessage(i18n.MessageTicketSearchDescription, utils.EscapeMarkdown(s.Query), total))
+
+	for _, result := range results {
+		name := fmt.Sprintf("#%d", result.TicketId)
+		if result.PanelTitle != nil {
+			name = fmt.Sprintf("#%d • %s", result.TicketId, *result.PanelTitle)
+		} else if result.Subject != nil {
+			name = fmt.Sprintf("#%d • %s", result.TicketId, *result.Subject)
+		}
+
+		e.AddField(utils.StringMax(name, 256), formatTicketSearchResult(cmd, result), false)
+	}
+
+	pageCount := (total + ticketSearchPageSize - 1) / ticketSearchPageSize
+	if pageCount == 0 {
+		pageCount = 1
+	}
+
+	e.SetFooter(cmd.GetMessage(i18n.MessageTicketSearchPage, s.Page+1, pageCount), "")
+
+	components := []component.Component{
+		component.BuildActionRow(
+			component.BuildButton(component.Button{
+				CustomId: state.Attach(fmt.Sprintf("ticketsearch_page_%d", s.Page-1), token),
+				Style:    component.ButtonStyleSecondary,
+				Emoji:    utils.BuildEmoji("◀️"),
+				Disabled: s.Page <= 0,
+			}),
+			component.BuildButton(component.Button{
+				CustomId: state.Attach(fmt.Sprintf("ticketsearch_page_%d", s.Page+1), token),
+				Style:    component.ButtonStyleSecondary,
+				Emoji:    utils.BuildEmoji("▶️"),
+				Disabled: s.Page >= pageCount-1,
+			}),
+		),
+	}
+
+	return e, components, nil
+}
+
+func formatTicketSearchResult(cmd registry.CommandContext, result dbclient.TicketSearchResult) string {
+	lines := []string{
+		cmd.GetMessage(i18n.MessageTicketSearchOpenedBy, result.UserId, message.BuildTimestamp(result.OpenTime, message.TimestampStyleShortDateTime)),
+	}
+
+	if result.CloseTime != nil {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryClosed, message.BuildTimestamp(*result.CloseTime, message.TimestampStyleShortDateTime)))
+	}
+
+	if result.CloseReason != nil {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryReason, utils.EscapeMarkdown(utils.StringMax(*result.CloseReason, 200, "..."))))
+	}
+
+	if result.ClaimedBy != nil {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryClaimedBy, *result.ClaimedBy))
+	}
+
+	if result.HasTranscript {
+		lines = append(lines, cmd.GetMessage(i18n.MessageTicketHistoryTranscript, TranscriptUrl(cmd.GuildId(), result.TicketId)))
+	}
+
+	return strings.Join(lines, "\n")
+}
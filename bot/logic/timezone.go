@@ -0,0 +1,34 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+)
+
+// GetGuildLocation returns the timezone that the guild has chosen, or UTC if it has not chosen one
+func GetGuildLocation(ctx context.Context, guildId uint64) (*time.Location, error) {
+	timezone, err := dbclient.Local.GuildTimezones.Get(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := utils.LoadTimezone(timezone)
+	if err != nil {
+		// The timezone was valid when it was set, but may have since been removed from the timezone database, so fall
+		// back to UTC rather than failing whatever needed the time, but log it so that it can be looked into
+		err = fmt.Errorf("guild has an invalid timezone %q: %w", timezone, err)
+		fmt.Print(err, errorcontext.WorkerErrorContext{Guild: guildId})
+		return time.UTC, nil
+	}
+
+	return location, nil
+}
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -10,12 +11,13 @@ import (
 	"time"
 
 	database "github.com/jadevelopmentgrp/Tickets-Database"
-	"github.com/jadevelopmentgrp/Tickets-Utilities/integrations/bloxlink"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations/linkedaccounts"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel/embed"
@@ -35,6 +37,7 @@ func SendWelcomeMessage(
 	formData map[database.FormInput]string,
 	// Only custom integration placeholders for now - prevent making duplicate requests
 	additionalPlaceholders map[string]string,
+	isAppeal bool,
 ) (uint64, error) {
 	settings, err := dbclient.Client.Settings.Get(ctx, ticket.GuildId)
 	if err != nil {
@@ -88,6 +91,16 @@ func SendWelcomeMessage(
 		}))
 	}
 
+	// Let staff lift the blacklist straight from the appeal
+	if isAppeal {
+		buttons = append(buttons, component.BuildButton(component.Button{
+			Label:    cmd.GetMessage(i18n.TitleUnblacklist),
+			CustomId: "unblacklist",
+			Style:    component.ButtonStyleSecondary,
+			Emoji:    &emoji.Emoji{Name: "🔓"},
+		}))
+	}
+
 	data := rest.CreateMessageData{
 		Embeds: embeds,
 		Components: []component.Component{
@@ -155,6 +168,10 @@ func DoPlaceholderSubstitutions(
 	// Only custom integration placeholders for now - prevent making duplicate requests
 	additionalPlaceholders map[string]string,
 ) string {
+	// Templates are rendered first, so that their output may still contain %placeholders%. User-supplied text in the
+	// output is escaped, so that only placeholders written by the guild are substituted.
+	message = renderTemplate(ctx, message, worker, ticket, additionalPlaceholders)
+
 	var lock sync.Mutex
 
 	// do DB lookups in parallel
@@ -182,7 +199,7 @@ func DoPlaceholderSubstitutions(
 	}
 
 	// Group substitutions
-	for _, substitutor := range groupSubstitutions {
+	for _, substitutor := range linkedAccountSubstitutors() {
 		substitutor := substitutor
 
 		contains := false
@@ -234,7 +251,7 @@ func DoPlaceholderSubstitutions(
 		fmt.Print(err)
 	}
 
-	return message
+	return unescapePlaceholders(message)
 }
 
 func fetchCustomIntegrationPlaceholders(
@@ -242,79 +259,115 @@ func fetchCustomIntegrationPlaceholders(
 	ticket database.Ticket,
 	formAnswers map[string]*string,
 ) (map[string]string, error) {
-	// Custom integrations
-	guildIntegrations, err := dbclient.Client.CustomIntegrationGuilds.GetGuildIntegrations(ctx, ticket.GuildId)
+	results, err := fetchCustomIntegrations(ctx, ticket, formAnswers)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch integrations
-	if len(guildIntegrations) > 0 {
-		integrationIds := make([]int, len(guildIntegrations))
-		for i, integration := range guildIntegrations {
-			integrationIds[i] = integration.Id
+	m := make(map[string]string) // Merge responses into 1 map
+	for _, result := range results {
+		if result.Err != nil {
+			// A failing integration should not prevent the placeholders of other integrations from being used
+			err := fmt.Errorf("custom integration %d failed: %w", result.Integration.Id, result.Err)
+			fmt.Print(err, errorcontext.WorkerErrorContext{Guild: ticket.GuildId, User: ticket.UserId})
+			continue
 		}
 
-		placeholders, err := dbclient.Client.CustomIntegrationPlaceholders.GetAllActivatedInGuild(ctx, ticket.GuildId)
-		if err != nil {
-			return nil, err
+		for key, value := range result.Values {
+			m[key] = value
 		}
+	}
 
-		// Determine which integrations we need to fetch
-		placeholderMap := make(map[int][]database.CustomIntegrationPlaceholder) // integration_id -> []Placeholder
-		for _, placeholder := range placeholders {
-			if _, ok := placeholderMap[placeholder.IntegrationId]; !ok {
-				placeholderMap[placeholder.IntegrationId] = []database.CustomIntegrationPlaceholder{}
-			}
+	return m, nil
+}
 
-			placeholderMap[placeholder.IntegrationId] = append(placeholderMap[placeholder.IntegrationId], placeholder)
-		}
+type customIntegrationResult struct {
+	Integration  database.CustomIntegration
+	Placeholders []database.CustomIntegrationPlaceholder
+	Values       map[string]string
+	Response     []byte
+	Err          error
+}
 
-		secrets, err := dbclient.Client.CustomIntegrationSecretValues.GetAll(ctx, ticket.GuildId, integrationIds)
-		if err != nil {
-			return nil, err
-		}
+// fetchCustomIntegrations calls each of the guild's integrations, returning the result of each individually
+func fetchCustomIntegrations(
+	ctx context.Context,
+	ticket database.Ticket,
+	formAnswers map[string]*string,
+) ([]customIntegrationResult, error) {
+	guildIntegrations, err := dbclient.Client.CustomIntegrationGuilds.GetGuildIntegrations(ctx, ticket.GuildId)
+	if err != nil {
+		return nil, err
+	}
 
-		headers, err := dbclient.Client.CustomIntegrationHeaders.GetAll(ctx, integrationIds)
-		if err != nil {
-			return nil, err
-		}
+	if len(guildIntegrations) == 0 {
+		return nil, nil
+	}
 
-		// Replace placeholders
-		group, _ := errgroup.WithContext(ctx)
+	integrationIds := make([]int, len(guildIntegrations))
+	for i, integration := range guildIntegrations {
+		integrationIds[i] = integration.Id
+	}
 
-		var lock sync.Mutex
-		m := make(map[string]string) // Merge responses into 1 map
+	placeholders, err := dbclient.Client.CustomIntegrationPlaceholders.GetAllActivatedInGuild(ctx, ticket.GuildId)
+	if err != nil {
+		return nil, err
+	}
 
-		for _, integration := range guildIntegrations {
-			integration := integration
-			integrationSecrets := secrets[integration.Id]
+	// Determine which integrations we need to fetch
+	placeholderMap := make(map[int][]database.CustomIntegrationPlaceholder) // integration_id -> []Placeholder
+	for _, placeholder := range placeholders {
+		placeholderMap[placeholder.IntegrationId] = append(placeholderMap[placeholder.IntegrationId], placeholder)
+	}
 
-			group.Go(func() error {
-				response, err := integrations.Fetch(ctx, integration, ticket, integrationSecrets, headers[integration.Id], placeholderMap[integration.Id], formAnswers)
-				if err != nil {
-					return err
-				}
+	secrets, err := dbclient.Client.CustomIntegrationSecretValues.GetAll(ctx, ticket.GuildId, integrationIds)
+	if err != nil {
+		return nil, err
+	}
 
-				lock.Lock()
-				defer lock.Unlock()
+	headers, err := dbclient.Client.CustomIntegrationHeaders.GetAll(ctx, integrationIds)
+	if err != nil {
+		return nil, err
+	}
 
-				for key, value := range response {
-					m[key] = value
-				}
+	settings, err := dbclient.Local.CustomIntegrationSettings.GetAll(ctx, ticket.GuildId, integrationIds)
+	if err != nil {
+		return nil, err
+	}
 
-				return nil
-			})
-		}
+	location, err := GetGuildLocation(ctx, ticket.GuildId)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := group.Wait(); err != nil {
-			return nil, err
-		}
+	// Each goroutine writes to its own index, so no lock is needed
+	results := make([]customIntegrationResult, len(guildIntegrations))
 
-		return m, nil
-	} else {
-		return make(map[string]string), nil
+	group, _ := errgroup.WithContext(ctx)
+	for i, integration := range guildIntegrations {
+		i := i
+		integration := integration
+
+		group.Go(func() error {
+			values, response, err := integrations.FetchWithResponse(ctx, integration, settings[integration.Id], ticket, secrets[integration.Id], headers[integration.Id], placeholderMap[integration.Id], formAnswers, location)
+
+			results[i] = customIntegrationResult{
+				Integration:  integration,
+				Placeholders: placeholderMap[integration.Id],
+				Values:       values,
+				Response:     response,
+				Err:          err,
+			}
+
+			return nil
+		})
 	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
 }
 
 // TODO: Error handling
@@ -380,6 +433,16 @@ var substitutions = map[string]PlaceholderSubstitutionFunc{
 	"datetime": func(ctx context.Context, worker *worker.Context, ticket database.Ticket) string {
 		return fmt.Sprintf("<t:%d:f>", time.Now().Unix())
 	},
+	// The above are shown in each reader's own timezone, but this is plain text in the guild's timezone
+	"local_time": func(ctx context.Context, worker *worker.Context, ticket database.Ticket) string {
+		location, err := GetGuildLocation(ctx, ticket.GuildId)
+		if err != nil {
+			fmt.Print(err)
+			return ""
+		}
+
+		return utils.FormatDateTime(time.Now(), location)
+	},
 	"first_response_time_weekly": func(ctx context.Context, worker *worker.Context, ticket database.Ticket) string {
 		data, err := dbclient.Analytics.GetFirstResponseTimeStats(ctx, ticket.GuildId)
 		if err != nil {
@@ -432,29 +495,29 @@ func NewGroupSubstitutor(placeholders []string, f GroupSubstitutionFunc) GroupSu
 	}
 }
 
-var groupSubstitutions = []GroupSubstitutor{
-	NewGroupSubstitutor([]string{"roblox_username", "roblox_id", "roblox_display_name", "roblox_profile_url", "roblox_account_age", "roblox_account_created"},
-		func(ctx context.Context, worker *worker.Context, ticket database.Ticket) map[string]string {
-			user, err := integrations.Bloxlink.GetRobloxUser(ctx, ticket.UserId)
+// linkedAccountSubstitutors returns a substitutor for each of the configured linked account providers
+func linkedAccountSubstitutors() []GroupSubstitutor {
+	providers := integrations.LinkedAccounts.Providers()
+
+	substitutors := make([]GroupSubstitutor, len(providers))
+	for i, provider := range providers {
+		provider := provider
+
+		substitutors[i] = NewGroupSubstitutor(provider.Placeholders(), func(ctx context.Context, worker *worker.Context, ticket database.Ticket) map[string]string {
+			values, err := provider.Lookup(ctx, ticket.UserId)
 			if err != nil {
-				if err == bloxlink.ErrUserNotFound {
-					return nil
-				} else {
+				if !errors.Is(err, linkedaccounts.ErrNotLinked) {
 					fmt.Print(err)
-					return nil
 				}
-			}
 
-			return map[string]string{
-				"roblox_username":        user.Name,
-				"roblox_id":              strconv.Itoa(user.Id),
-				"roblox_display_name":    user.DisplayName,
-				"roblox_profile_url":     fmt.Sprintf("https://www.roblox.com/users/%d/profile", user.Id),
-				"roblox_account_age":     fmt.Sprintf("<t:%d:R>", user.Created.Unix()),
-				"roblox_account_created": fmt.Sprintf("<t:%d:D>", user.Created.Unix()),
+				return nil
 			}
-		},
-	),
+
+			return values
+		})
+	}
+
+	return substitutors
 }
 
 func formAnswersToMap(formData map[database.FormInput]string) map[string]*string {
@@ -5,6 +5,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"strconv"
+	"time"
 )
 
 const (
@@ -13,8 +14,12 @@ const (
 )
 
 var (
-	IntegrationRequests = newCounterVec("integration_requests", "integration_id", "integration_name", "guild_id")
-	TicketsCreated      = newCounter("tickets_created")
+	IntegrationRequests        = newCounterVec("integration_requests", "integration_id", "integration_name", "guild_id")
+	IntegrationRequestDuration = newHistogramVec("integration_request_duration", "integration_id", "integration_name")
+	IntegrationErrors          = newCounterVec("integration_errors", "integration_id", "integration_name", "reason")
+	IntegrationCacheHits       = newCounterVec("integration_cache_hits", "integration_id", "integration_name")
+	IntegrationCircuitOpens    = newCounterVec("integration_circuit_opens", "integration_id", "integration_name")
+	TicketsCreated             = newCounter("tickets_created")
 
 	Commands = newCounterVec("commands", "command")
 
@@ -31,6 +36,8 @@ var (
 	ActiveInteractions        = newGauge("active_interactions")
 	InteractionTimeToComplete = newHistogram("interaction_time_to_complete")
 
+	ComponentSignatureFailures = newCounterVec("component_signature_failures", "component_type", "reason")
+
 	ForwardedDashboardMessages = newCounter("forwarded_dashboard_messages")
 
 	Events         = newCounterVec("events", "event_type")
@@ -88,6 +95,26 @@ func LogIntegrationRequest(integration database.CustomIntegration, guildId uint6
 	).Inc()
 }
 
+func LogIntegrationResponse(integration database.CustomIntegration, duration time.Duration) {
+	IntegrationRequestDuration.WithLabelValues(strconv.Itoa(integration.Id), integration.Name).Observe(duration.Seconds())
+}
+
+// LogIntegrationError records a failed integration call. Reason is one of error, timeout, circuit_open or
+// invalid_response.
+func LogIntegrationError(integration database.CustomIntegration, reason string) {
+	IntegrationErrors.WithLabelValues(strconv.Itoa(integration.Id), integration.Name, reason).Inc()
+}
+
+func LogIntegrationCacheHit(integration database.CustomIntegration) {
+	IntegrationCacheHits.WithLabelValues(strconv.Itoa(integration.Id), integration.Name).Inc()
+}
+
+// LogIntegrationCircuitOpened records a guild's circuit for the integration being opened. Each guild has its own
+// circuit, so the number of openings is recorded rather than whether the circuit is open.
+func LogIntegrationCircuitOpened(integration database.CustomIntegration) {
+	IntegrationCircuitOpens.WithLabelValues(strconv.Itoa(integration.Id), integration.Name).Inc()
+}
+
 func LogCommand(command string) {
 	Commands.WithLabelValues(command).Inc()
 }
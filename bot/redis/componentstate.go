@@ -0,0 +1,59 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var ErrComponentStateNotFound = errors.New("component state not found")
+
+func GetComponentState(ctx context.Context, token string) ([]byte, error) {
+	res, err := Client.Get(ctx, buildComponentStateKey(token)).Bytes()
+	if err != nil {
+		if errors.Is(err, ErrNil) {
+			return nil, ErrComponentStateNotFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// StoreComponentState stores the state under the token, returning false if the token is already in use
+func StoreComponentState(ctx context.Context, token string, data []byte, expiry time.Duration) (bool, error) {
+	return Client.SetNX(ctx, buildComponentStateKey(token), data, expiry).Result()
+}
+
+// UpdateComponentState replaces existing state without extending its expiry
+func UpdateComponentState(ctx context.Context, token string, data []byte) error {
+	ok, err := Client.SetXX(ctx, buildComponentStateKey(token), data, redis.KeepTTL).Result()
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrComponentStateNotFound
+	}
+
+	return nil
+}
+
+// DeleteComponentState removes the state, returning false if it did not exist, e.g. because it has already been
+// deleted by a concurrent interaction
+func DeleteComponentState(ctx context.Context, token string) (bool, error) {
+	res, err := Client.Del(ctx, buildComponentStateKey(token)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return res > 0, nil
+}
+
+func buildComponentStateKey(token string) string {
+	return fmt.Sprintf("tickets:componentstate:%s", token)
+}
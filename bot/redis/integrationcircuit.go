@@ -0,0 +1,98 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// recordFailureScript counts a failure, opening the circuit once the threshold is reached within the window. A failure
+// while the circuit is half-open, i.e. just after it has closed again, re-opens it immediately.
+var recordFailureScript = redis.NewScript(`
+local failures = redis.call("INCR", KEYS[1])
+if failures == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+
+if failures >= tonumber(ARGV[1]) or redis.call("EXISTS", KEYS[3]) == 1 then
+	redis.call("SET", KEYS[2], "1", "PX", ARGV[3])
+	redis.call("SET", KEYS[3], "1", "PX", ARGV[4])
+	redis.call("DEL", KEYS[1])
+	return 1
+end
+
+return 0
+`)
+
+// IsIntegrationCircuitOpen returns whether the guild's calls to the integration are currently being skipped. Public
+// integrations are shared between guilds, so each guild has its own circuit, and failures caused by one guild's
+// secrets do not stop the integration from working in other guilds.
+func IsIntegrationCircuitOpen(ctx context.Context, guildId uint64, integrationId int) (bool, error) {
+	res, err := Client.Exists(ctx, buildIntegrationCircuitOpenKey(guildId, integrationId)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return res == 1, nil
+}
+
+// RecordIntegrationFailure counts a failed call to the integration from the guild, returning true if the circuit has
+// been opened
+func RecordIntegrationFailure(ctx context.Context, guildId uint64, integrationId, threshold int, window, openDuration time.Duration) (bool, error) {
+	keys := []string{
+		buildIntegrationFailuresKey(guildId, integrationId),
+		buildIntegrationCircuitOpenKey(guildId, integrationId),
+		buildIntegrationHalfOpenKey(guildId, integrationId),
+	}
+
+	// The circuit stays half-open for a while after it closes, so that a single failure re-opens it
+	res, err := recordFailureScript.Run(ctx, Client, keys, threshold, window.Milliseconds(), openDuration.Milliseconds(), (openDuration * 2).Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return res == 1, nil
+}
+
+// RecordIntegrationSuccess resets the guild's failure count for the integration, fully closing the circuit
+func RecordIntegrationSuccess(ctx context.Context, guildId uint64, integrationId int) error {
+	return Client.Del(ctx, buildIntegrationFailuresKey(guildId, integrationId), buildIntegrationHalfOpenKey(guildId, integrationId)).Err()
+}
+
+// GetIntegrationResponse returns a response cached for the guild, or false if there is none. Responses are cached per
+// guild, as each guild sets its own cache TTL.
+func GetIntegrationResponse(ctx context.Context, guildId uint64, integrationId int, hash string) ([]byte, bool, error) {
+	res, err := Client.Get(ctx, buildIntegrationResponseKey(guildId, integrationId, hash)).Bytes()
+	if err != nil {
+		if errors.Is(err, ErrNil) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+func StoreIntegrationResponse(ctx context.Context, guildId uint64, integrationId int, hash string, response []byte, ttl time.Duration) error {
+	return Client.Set(ctx, buildIntegrationResponseKey(guildId, integrationId, hash), response, ttl).Err()
+}
+
+func buildIntegrationFailuresKey(guildId uint64, integrationId int) string {
+	return fmt.Sprintf("tickets:integrations:%d:%d:failures", guildId, integrationId)
+}
+
+func buildIntegrationCircuitOpenKey(guildId uint64, integrationId int) string {
+	return fmt.Sprintf("tickets:integrations:%d:%d:circuit_open", guildId, integrationId)
+}
+
+func buildIntegrationHalfOpenKey(guildId uint64, integrationId int) string {
+	return fmt.Sprintf("tickets:integrations:%d:%d:half_open", guildId, integrationId)
+}
+
+func buildIntegrationResponseKey(guildId uint64, integrationId int, hash string) string {
+	return fmt.Sprintf("tickets:integrations:%d:%d:response:%s", guildId, integrationId, hash)
+}
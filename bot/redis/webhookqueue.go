@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const webhookQueueKey = "tickets:webhooks:queue"
+
+// leaseDueScript atomically returns the deliveries that are due and pushes them back by the lease duration, so that
+// each delivery is only taken by one worker. If the worker dies before acknowledging the delivery, it becomes due
+// again once the lease expires.
+var leaseDueScript = redis.NewScript(`
+local due = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "LIMIT", 0, ARGV[2])
+for _, member in ipairs(due) do
+	redis.call("ZADD", KEYS[1], "XX", ARGV[3], member)
+end
+
+return due
+`)
+
+// QueueWebhookDelivery schedules a webhook delivery to be attempted at the given time
+func QueueWebhookDelivery(ctx context.Context, delivery []byte, at time.Time) error {
+	return Client.ZAdd(ctx, webhookQueueKey, &redis.Z{
+		Score:  float64(at.UnixMilli()),
+		Member: delivery,
+	}).Err()
+}
+
+// LeaseDueWebhookDeliveries returns up to limit deliveries that are due to be attempted, leasing them for the given
+// duration. Each delivery must be acknowledged with AckWebhookDelivery once it has been attempted, otherwise it is
+// attempted again when the lease expires, so deliveries are at-least-once.
+func LeaseDueWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([][]byte, error) {
+	now := time.Now()
+	res, err := leaseDueScript.Run(
+		ctx,
+		Client,
+		[]string{webhookQueueKey},
+		strconv.FormatInt(now.UnixMilli(), 10),
+		limit,
+		strconv.FormatInt(now.Add(lease).UnixMilli(), 10),
+	).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	members, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("webhook queue returned %v, not a list", res)
+	}
+
+	deliveries := make([][]byte, 0, len(members))
+	for _, member := range members {
+		str, ok := member.(string)
+		if !ok {
+			return nil, fmt.Errorf("webhook queue returned %v, not a string", member)
+		}
+
+		deliveries = append(deliveries, []byte(str))
+	}
+
+	return deliveries, nil
+}
+
+// AckWebhookDelivery removes a leased delivery from the queue
+func AckWebhookDelivery(ctx context.Context, delivery []byte) error {
+	return Client.ZRem(ctx, webhookQueueKey, delivery).Err()
+}
@@ -0,0 +1,87 @@
+package templating
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+)
+
+// funcs are the filters available to templates, used as {{.Value | name args}}. The piped value is always the last
+// argument.
+var funcs = template.FuncMap{
+	"upper":     strings.ToUpper,
+	"lower":     strings.ToLower,
+	"title":     title,
+	"trim":      strings.TrimSpace,
+	"truncate":  truncate,
+	"default":   defaultValue,
+	"replace":   replace,
+	"date":      date,
+	"timestamp": timestamp,
+}
+
+// title capitalises the first letter of each word
+func title(s string) string {
+	words := strings.Fields(s)
+	for i, word := range words {
+		r, size := utf8.DecodeRuneInString(word)
+		words[i] = strings.ToUpper(string(r)) + word[size:]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// truncate shortens s to at most length characters, ending with ... if anything was removed
+func truncate(length int, s string) string {
+	if length < 0 || utf8.RuneCountInString(s) <= length {
+		return s
+	}
+
+	runes := []rune(s)
+	if length <= 3 {
+		return string(runes[:length])
+	}
+
+	return string(runes[:length-3]) + "..."
+}
+
+// defaultValue returns def if value is empty, e.g. an empty string, zero or a missing placeholder
+func defaultValue(def string, value any) any {
+	if value == nil {
+		return def
+	}
+
+	v := reflect.ValueOf(value)
+	if v.IsZero() {
+		return def
+	}
+
+	if v.Kind() == reflect.String && strings.TrimSpace(v.String()) == "" {
+		return def
+	}
+
+	return value
+}
+
+func replace(old, new, s string) string {
+	return strings.ReplaceAll(s, old, new)
+}
+
+// date formats a time with a Go layout, e.g. "2006-01-02 15:04", in the time's own location
+func date(layout string, t time.Time) string {
+	return t.Format(layout)
+}
+
+// timestamp formats a time as a Discord timestamp, shown in each reader's own timezone. Style is one of Discord's
+// timestamp styles: t, T, d, D, f, F or R.
+func timestamp(style string, t time.Time) (string, error) {
+	switch style {
+	case "t", "T", "d", "D", "f", "F", "R":
+		return fmt.Sprintf("<t:%d:%s>", t.Unix(), style), nil
+	default:
+		return "", fmt.Errorf("unknown timestamp style %q", style)
+	}
+}
@@ -0,0 +1,217 @@
+// Package templating renders the template syntax that guilds may opt in to for welcome messages, tags and embeds. It
+// is a restricted form of text/template:
+//
+//	{{if .Claimer}}Claimed by {{.Claimer}}{{else}}Unclaimed{{end}}
+//	{{range .FormAnswers}}**{{.Question}}**: {{.Answer | truncate 100}}{{end}}
+//	{{.Username | upper}}, {{.Now | date "2006-01-02"}}, {{.Placeholder "roblox_username" | default "not linked"}}
+//
+// Templates cannot define or call other templates, and range may only iterate over .FormAnswers and may not be nested
+// inside another range, so that a template's running time grows no faster than its length multiplied by the number of
+// form answers.
+// Rendering is also limited in output size and time.
+package templating
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+const (
+	MaxTemplateLength = 4096
+	// MaxOutputLength is the longest that an embed description may be, which is the longest field a template renders
+	MaxOutputLength = 4096
+	DefaultTimeout  = time.Second * 2
+)
+
+var (
+	ErrTemplateTooLong = fmt.Errorf("template is longer than %d characters", MaxTemplateLength)
+	ErrOutputTooLong   = fmt.Errorf("template output is longer than %d characters", MaxOutputLength)
+	ErrTimedOut        = errors.New("template took too long to render")
+)
+
+// IsTemplate returns whether the text contains any template actions. Text without actions is left for the
+// %placeholder% substitution.
+func IsTemplate(s string) bool {
+	return strings.Contains(s, "{{")
+}
+
+// Parse parses and validates a template, without rendering it
+func Parse(src string) (*template.Template, error) {
+	if len(src) > MaxTemplateLength {
+		return nil, ErrTemplateTooLong
+	}
+
+	tmpl, err := template.New("message").
+		Option("missingkey=zero").
+		Funcs(funcs).
+		Parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tmpl.Templates()) > 1 {
+		return nil, errors.New("templates may not define other templates")
+	}
+
+	if tmpl.Tree != nil {
+		if err := validate(tmpl.Tree.Root, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return tmpl, nil
+}
+
+// Render parses and executes the template against data, giving up after the context's deadline or DefaultTimeout
+func Render(ctx context.Context, src string, data any) (string, error) {
+	tmpl, err := Parse(src)
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultTimeout)
+		defer cancel()
+	}
+
+	w := &limitedWriter{ctx: ctx}
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			// Functions and methods on the data should not panic, but a bad template must never crash the worker
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("template panicked: %v", r)
+			}
+		}()
+
+		done <- tmpl.Execute(w, data)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", err
+		}
+
+		return w.sb.String(), nil
+	case <-ctx.Done():
+		return "", ErrTimedOut
+	}
+}
+
+// validate rejects nodes that could make a template run for longer than its data allows. The time limit in Render
+// only stops waiting for the result, and a template that writes nothing is never interrupted, so templates must be
+// bounded before they are executed: nested ranges would multiply the size of the data once per level.
+func validate(node parse.Node, inRange bool) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+
+		for _, child := range n.Nodes {
+			if err := validate(child, inRange); err != nil {
+				return err
+			}
+		}
+	case *parse.TemplateNode:
+		return fmt.Errorf("templates may not call other templates (%s)", n.Name)
+	case *parse.IfNode:
+		return validateBranch(&n.BranchNode, inRange)
+	case *parse.WithNode:
+		return validateBranch(&n.BranchNode, inRange)
+	case *parse.RangeNode:
+		if inRange {
+			return errors.New("range may not be used inside another range")
+		}
+
+		if !isRangeablePipe(n.Pipe) {
+			return fmt.Errorf("range may only be used on %s", strings.Join(rangeableFieldNames(), ", "))
+		}
+
+		if err := validate(n.List, true); err != nil {
+			return err
+		}
+
+		// The else branch only runs when there is nothing to iterate over
+		if n.ElseList != nil {
+			return validate(n.ElseList, inRange)
+		}
+	}
+
+	return nil
+}
+
+func validateBranch(branch *parse.BranchNode, inRange bool) error {
+	if err := validate(branch.List, inRange); err != nil {
+		return err
+	}
+
+	if branch.ElseList != nil {
+		return validate(branch.ElseList, inRange)
+	}
+
+	return nil
+}
+
+// rangeableFields are the only fields that range may iterate over. The data passed to Render must only use these names
+// for slices. text/template can also range over integers, so ranging over any other field, such as .Now.UnixNano, or
+// over the result of a function, could loop for an arbitrary length of time without writing anything.
+var rangeableFields = map[string]bool{
+	"FormAnswers": true,
+}
+
+func rangeableFieldNames() []string {
+	names := make([]string, 0, len(rangeableFields))
+	for name := range rangeableFields {
+		names = append(names, "."+name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// isRangeablePipe returns whether a pipeline is a single rangeable field of the data, such as .FormAnswers or
+// $.FormAnswers
+func isRangeablePipe(pipe *parse.PipeNode) bool {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode:
+		return len(arg.Ident) == 1 && rangeableFields[arg.Ident[0]]
+	case *parse.VariableNode:
+		// $.Field, not a bare variable that may hold a number, nor a variable declared with another value
+		return len(arg.Ident) == 2 && arg.Ident[0] == "$" && rangeableFields[arg.Ident[1]]
+	default:
+		return false
+	}
+}
+
+// limitedWriter stops the template once the output limit is reached or the context is done. Checking the context on
+// every write stops long-running templates early, as well as ones that would produce too much output.
+type limitedWriter struct {
+	ctx context.Context
+	sb  strings.Builder
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.ctx.Err() != nil {
+		return 0, ErrTimedOut
+	}
+
+	if w.sb.Len()+len(p) > MaxOutputLength {
+		return 0, ErrOutputTooLong
+	}
+
+	return w.sb.Write(p)
+}
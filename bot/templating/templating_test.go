@@ -0,0 +1,161 @@
+package templating
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testAnswer struct {
+	Question string
+	Answer   string
+}
+
+type testData struct {
+	Username    string
+	Claimer     string
+	Count       int
+	Now         time.Time
+	FormAnswers []testAnswer
+}
+
+func (testData) Placeholder(name string) string {
+	if name == "known" {
+		return "value"
+	}
+
+	return ""
+}
+
+var data = testData{
+	Username: "alice smith",
+	Now:      time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+	FormAnswers: []testAnswer{
+		{Question: "Server", Answer: "EU"},
+		{Question: "Issue", Answer: "The game crashes when I join"},
+	},
+}
+
+func render(t *testing.T, src string) string {
+	out, err := Render(context.Background(), src, data)
+	require.NoError(t, err, src)
+	return out
+}
+
+func TestRender(t *testing.T) {
+	cases := map[string]string{
+		"Hello {{.Username}}":                                                   "Hello alice smith",
+		"{{.Username | upper}}":                                                 "ALICE SMITH",
+		"{{.Username | title}}":                                                 "Alice Smith",
+		"{{.Username | truncate 8}}":                                            "alice...",
+		"{{.Username | truncate 50}}":                                           "alice smith",
+		"{{if .Claimer}}Claimed{{else}}Unclaimed{{end}}":                        "Unclaimed",
+		"{{.Claimer | default \"nobody\"}}":                                     "nobody",
+		"{{.Count | default \"none\"}}":                                         "none",
+		"{{.Username | default \"nobody\"}}":                                    "alice smith",
+		"{{.Now | date \"2006-01-02 15:04\"}}":                                  "2024-03-01 12:30",
+		"{{.Now | timestamp \"R\"}}":                                            "<t:1709296200:R>",
+		"{{.Placeholder \"known\"}}":                                            "value",
+		"{{.Placeholder \"missing\" | default \"N/A\"}}":                        "N/A",
+		"{{.Username | replace \"smith\" \"jones\"}}":                           "alice jones",
+		"{{range .FormAnswers}}{{.Question}}={{.Answer | truncate 10}};{{end}}": "Server=EU;Issue=The gam...;",
+		"{{range $i, $a := $.FormAnswers}}{{$i}}{{end}}":                        "01",
+		"no template %user%":                                                    "no template %user%",
+	}
+
+	for src, expected := range cases {
+		require.Equal(t, expected, render(t, src), src)
+	}
+}
+
+func TestRenderDateInLocation(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	local := testData{Now: data.Now.In(location)}
+
+	actual, err := Render(context.Background(), `{{.Now | date "2006-01-02 15:04 MST"}}`, local)
+	require.NoError(t, err)
+	require.Equal(t, "2024-03-01 07:30 EST", actual)
+
+	// Discord timestamps are unaffected, as they are shown in each reader's own timezone
+	actual, err = Render(context.Background(), `{{.Now | timestamp "R"}}`, local)
+	require.NoError(t, err)
+	require.Equal(t, "<t:1709296200:R>", actual)
+}
+
+func TestRenderRejectsUnsafeTemplates(t *testing.T) {
+	unsafe := []string{
+		`{{define "a"}}x{{end}}`,
+		`{{template "a"}}`,
+		`{{range 1000000000}}{{end}}`,
+		`{{$n := 1000000000}}{{range $n}}{{end}}`,
+		`{{range .Username | len}}{{end}}`,
+		`{{if .Claimer}}{{range 5}}{{end}}{{end}}`,
+		`{{range .Now.UnixNano}}{{end}}`,
+		`{{range $.Now.UnixNano}}{{end}}`,
+		`{{range .Count}}{{end}}`,
+		`{{range .Username}}{{end}}`,
+		`{{with .Now}}{{range .YearDay}}{{end}}{{end}}`,
+		`{{$a := .FormAnswers}}{{range $a}}{{end}}`,
+		`{{range .FormAnswers}}{{range $.FormAnswers}}{{end}}{{end}}`,
+		`{{range .FormAnswers}}{{with .}}{{range $.FormAnswers}}{{end}}{{end}}{{end}}`,
+		`{{range .FormAnswers}}{{else}}{{range .FormAnswers}}{{range .FormAnswers}}{{end}}{{end}}{{end}}`,
+		`{{.Unknown}}`,
+		`{{.Username`,
+	}
+
+	for _, src := range unsafe {
+		_, err := Render(context.Background(), src, data)
+		require.Error(t, err, src)
+	}
+}
+
+func TestRenderLimits(t *testing.T) {
+	_, err := Render(context.Background(), strings.Repeat("a", MaxTemplateLength+1), data)
+	require.ErrorIs(t, err, ErrTemplateTooLong)
+
+	// Each answer is long enough that ranging over them produces more output than allowed
+	large := testData{FormAnswers: make([]testAnswer, 100)}
+	for i := range large.FormAnswers {
+		large.FormAnswers[i] = testAnswer{Answer: strings.Repeat("x", 100)}
+	}
+
+	_, err = Render(context.Background(), "{{range .FormAnswers}}{{.Answer}}{{end}}", large)
+	require.ErrorIs(t, err, ErrOutputTooLong)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = Render(ctx, "{{.Username}}", data)
+	require.ErrorIs(t, err, ErrTimedOut)
+}
+
+// The time limit only stops waiting for the result, so a template that writes nothing must still finish on its own.
+// Receiving the result, rather than ErrTimedOut, shows that the execution goroutine has returned.
+func TestRenderSilentTemplateFinishes(t *testing.T) {
+	large := testData{FormAnswers: make([]testAnswer, 1000)}
+
+	loop := "{{range $.FormAnswers}}{{end}}"
+	src := strings.Repeat(loop, MaxTemplateLength/len(loop))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	out, err := Render(ctx, src, large)
+	require.NoError(t, err)
+	require.Empty(t, out)
+
+	nested := strings.Repeat("{{range $.FormAnswers}}", 30) + strings.Repeat("{{end}}", 30)
+	_, err = Render(ctx, nested, large)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrTimedOut)
+}
+
+func TestIsTemplate(t *testing.T) {
+	require.True(t, IsTemplate("Hello {{.Username}}"))
+	require.False(t, IsTemplate("Hello %user%"))
+}
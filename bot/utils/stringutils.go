@@ -4,6 +4,7 @@ import (
 	"golang.org/x/exp/constraints"
 	"math/rand"
 	"strings"
+	"unicode/utf8"
 )
 
 type Number interface {
@@ -18,6 +19,21 @@ func StringMax(str string, max int, suffix ...string) string {
 	return str
 }
 
+// TruncateRunes shortens the string to at most max characters, as counted by Discord, including the suffix. Unlike
+// StringMax, it never cuts a character in half.
+func TruncateRunes(str string, max int, suffix string) string {
+	if utf8.RuneCountInString(str) <= max {
+		return str
+	}
+
+	suffixRunes := []rune(suffix)
+	if len(suffixRunes) >= max {
+		return string(suffixRunes[:max])
+	}
+
+	return string([]rune(str)[:max-len(suffixRunes)]) + suffix
+}
+
 func Max[T Number](a, b T) T {
 	if a > b {
 		return a
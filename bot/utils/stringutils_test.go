@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTruncateRunesShort(t *testing.T) {
+	require.Equal(t, "hello", TruncateRunes("hello", 5, "..."))
+}
+
+func TestTruncateRunesIncludesSuffix(t *testing.T) {
+	require.Equal(t, "hello...", TruncateRunes("hello world", 8, "..."))
+}
+
+func TestTruncateRunesMultiByte(t *testing.T) {
+	require.Equal(t, "héllo wö…", TruncateRunes("héllo wörld", 9, "…"))
+}
+
+func TestTruncateRunesSuffixTooLong(t *testing.T) {
+	require.Equal(t, "..", TruncateRunes("hello", 2, "..."))
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"time"
+	_ "time/tzdata" // Guild timezones must load even if the host has no zoneinfo database
 )
 
 func FormatTime(interval time.Duration) string {
@@ -12,9 +13,10 @@ func FormatTime(interval time.Duration) string {
 	return fmt.Sprintf("%dh %02dm", hours, minutes)
 }
 
-func FormatDateTime(time time.Time) string {
-	zone, _ := time.Zone()
-	return fmt.Sprintf("%d/%d/%d %d:%d (%s)", time.Day(), time.Month(), time.Year(), time.Hour(), time.Minute(), zone)
+// FormatDateTime formats a time as plain text in the given location, for places where Discord timestamps are not
+// rendered, such as channel names and transcripts
+func FormatDateTime(t time.Time, location *time.Location) string {
+	return t.In(location).Format("02/01/2006 15:04 (MST)")
 }
 
 func FormatNullableTime(duration *time.Duration) string {
@@ -24,3 +26,13 @@ func FormatNullableTime(duration *time.Duration) string {
 		return FormatTime(*duration)
 	}
 }
+
+// LoadTimezone parses an IANA timezone name, e.g. Europe/London. The host's local timezone is rejected, as it is not
+// the same on every worker.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("invalid timezone %q", name)
+	}
+
+	return time.LoadLocation(name)
+}
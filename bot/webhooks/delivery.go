@@ -0,0 +1,170 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/integrations"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxAttempts is the number of times a delivery is attempted before it is dead-lettered
+	maxAttempts = 6
+
+	// retryBaseDelay is doubled after each failed attempt: 30s, 1m, 2m, 4m, 8m
+	retryBaseDelay = time.Second * 30
+
+	deliveryTimeout     = time.Second * 10
+	deliveryConcurrency = 20
+	pollInterval        = time.Second
+
+	// leaseDuration is how long a delivery is hidden from other workers while it is attempted. It must comfortably
+	// exceed deliveryTimeout, otherwise a slow delivery may be attempted twice.
+	leaseDuration = time.Minute
+
+	// bookkeepingTimeout bounds the requeue, dead-letter and acknowledgement writes made after an attempt
+	bookkeepingTimeout = time.Second * 5
+)
+
+var errNoSecret = errors.New("guild has no webhook signing secret")
+
+// Sign returns the signature of a payload, sent in the X-Tickets-Signature header. Receivers should compute the
+// HMAC-SHA256 of the X-Tickets-Timestamp header, a full stop and the raw request body, keyed with the guild's secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// StartDeliveryLoop polls the queue for deliveries that are due, and attempts them
+func StartDeliveryLoop(logger *zap.Logger) {
+	logger.Info("Starting webhook delivery loop")
+
+	sem := make(chan struct{}, deliveryConcurrency)
+
+	timer := time.NewTicker(pollInterval)
+	for {
+		<-timer.C
+
+		deliveries, err := redis.LeaseDueWebhookDeliveries(context.Background(), deliveryConcurrency, leaseDuration)
+		if err != nil {
+			logger.Error("Failed to fetch due webhook deliveries", zap.Error(err))
+			continue
+		}
+
+		for _, encoded := range deliveries {
+			var d delivery
+			if err := json.Unmarshal(encoded, &d); err != nil {
+				logger.Error("Failed to decode webhook delivery", zap.Error(err))
+				ack(logger, encoded)
+				continue
+			}
+
+			sem <- struct{}{}
+			go func() {
+				defer func() { <-sem }()
+				attempt(logger, d)
+				ack(logger, encoded)
+			}()
+		}
+	}
+}
+
+// attempt makes a delivery, and requeues or dead-letters it if it fails. The delivery is acknowledged by the caller
+// afterwards, so that a crash part way through leaves the lease in place.
+func attempt(logger *zap.Logger, d delivery) {
+	deliveryCtx, cancelDelivery := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancelDelivery()
+
+	d.Attempt++
+
+	err := deliver(deliveryCtx, d)
+	if err == nil {
+		return
+	}
+
+	// The delivery context may have expired, if the attempt timed out
+	ctx, cancel := context.WithTimeout(context.Background(), bookkeepingTimeout)
+	defer cancel()
+
+	logger.Debug("Webhook delivery failed",
+		zap.String("delivery_id", d.Id), zap.Int("webhook_id", d.WebhookId), zap.Int("attempt", d.Attempt), zap.Error(err))
+
+	if d.Attempt >= maxAttempts {
+		if err := dbclient.Local.OutboundWebhookDeadLetters.Create(ctx, dbclient.OutboundWebhookDeadLetter{
+			DeliveryId: d.Id,
+			WebhookId:  d.WebhookId,
+			GuildId:    d.GuildId,
+			Event:      string(d.Event),
+			Payload:    d.Payload,
+			Attempts:   d.Attempt,
+			Error:      err.Error(),
+			FailedAt:   time.Now(),
+		}); err != nil {
+			logger.Error("Failed to dead-letter webhook delivery", zap.String("delivery_id", d.Id), zap.Error(err))
+		}
+
+		return
+	}
+
+	delay := retryBaseDelay << (d.Attempt - 1)
+	if err := queue(ctx, d, time.Now().Add(delay)); err != nil {
+		logger.Error("Failed to requeue webhook delivery", zap.String("delivery_id", d.Id), zap.Error(err))
+	}
+}
+
+func deliver(ctx context.Context, d delivery) error {
+	webhook, ok, err := dbclient.Local.OutboundWebhooks.Get(ctx, d.WebhookId)
+	if err != nil {
+		return err
+	}
+
+	// The webhook has been removed since the event was queued
+	if !ok {
+		return nil
+	}
+
+	secret, ok, err := dbclient.Local.OutboundWebhookSecrets.Get(ctx, d.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errNoSecret
+	}
+
+	timestamp := time.Now().Unix()
+	headers := map[string]string{
+		"Content-Type":        "application/json",
+		"X-Tickets-Event":     string(d.Event),
+		"X-Tickets-Delivery":  d.Id,
+		"X-Tickets-Timestamp": strconv.FormatInt(timestamp, 10),
+		"X-Tickets-Signature": Sign(secret, timestamp, d.Payload),
+	}
+
+	// The payload is sent as raw bytes, as re-encoding it as JSON could change it and invalidate the signature
+	_, err = integrations.SecureProxy.DoRawRequest(ctx, http.MethodPost, webhook.Url, headers, d.Payload)
+	return err
+}
+
+func ack(logger *zap.Logger, encoded []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), bookkeepingTimeout)
+	defer cancel()
+
+	if err := redis.AckWebhookDelivery(ctx, encoded); err != nil {
+		logger.Error("Failed to acknowledge webhook delivery", zap.Error(err))
+	}
+}
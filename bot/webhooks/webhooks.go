@@ -0,0 +1,201 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+)
+
+type Event string
+
+const (
+	EventOpened         Event = "opened"
+	EventClaimed        Event = "claimed"
+	EventUnclaimed      Event = "unclaimed"
+	EventTransferred    Event = "transferred"
+	EventMemberAdded    Event = "member_added"
+	EventMemberRemoved  Event = "member_removed"
+	EventRenamed        Event = "renamed"
+	EventClosed         Event = "closed"
+	EventReopened       Event = "reopened"
+	EventRated          Event = "rated"
+	EventRatingReason   Event = "rating_reason"
+	EventSurveyAnswered Event = "survey_answered"
+)
+
+var Events = []Event{
+	EventOpened, EventClaimed, EventUnclaimed, EventTransferred, EventMemberAdded, EventMemberRemoved, EventRenamed,
+	EventClosed, EventReopened, EventRated, EventRatingReason, EventSurveyAnswered,
+}
+
+// MaxWebhooksPerGuild limits the number of outbound webhooks a guild may configure
+const MaxWebhooksPerGuild = 5
+
+type payload struct {
+	Id        string    `json:"id"`
+	Event     Event     `json:"event"`
+	GuildId   uint64    `json:"guild_id,string"`
+	TicketId  int       `json:"ticket_id"`
+	ActorId   *uint64   `json:"actor_id,string,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      any       `json:"data,omitempty"`
+}
+
+type (
+	OpenedData struct {
+		UserId    uint64  `json:"user_id,string"`
+		ChannelId *uint64 `json:"channel_id,string,omitempty"`
+		PanelId   *int    `json:"panel_id,omitempty"`
+		Subject   string  `json:"subject,omitempty"`
+	}
+
+	ClaimData struct {
+		ClaimerId uint64 `json:"claimer_id,string"`
+	}
+
+	TransferData struct {
+		PreviousClaimerId uint64 `json:"previous_claimer_id,string"`
+		ClaimerId         uint64 `json:"claimer_id,string"`
+	}
+
+	MemberData struct {
+		UserId uint64 `json:"user_id,string"`
+	}
+
+	RenameData struct {
+		Name string `json:"name"`
+	}
+
+	CloseData struct {
+		Reason *string `json:"reason,omitempty"`
+	}
+
+	// RatingData is sent with both EventRated and EventRatingReason. Rating is the score converted to 1-5 stars, so
+	// that webhooks written before rating scales were configurable keep working.
+	RatingData struct {
+		Rating uint8   `json:"rating"`
+		Scale  string  `json:"scale"`
+		Score  int     `json:"score"`
+		Reason *string `json:"reason,omitempty"`
+	}
+
+	SurveyData struct {
+		Answers []SurveyAnswer `json:"answers"`
+	}
+
+	SurveyAnswer struct {
+		Question string `json:"question"`
+		Answer   string `json:"answer"`
+	}
+)
+
+// delivery is a queued attempt to send an event to a webhook
+type delivery struct {
+	Id        string          `json:"id"`
+	WebhookId int             `json:"webhook_id"`
+	GuildId   uint64          `json:"guild_id"`
+	Event     Event           `json:"event"`
+	Payload   json.RawMessage `json:"payload"`
+	Attempt   int             `json:"attempt"`
+}
+
+// Dispatch queues the event for delivery to each of the guild's webhooks that is subscribed to it. actorId is the user
+// who triggered the event, or 0 if it was triggered automatically.
+func Dispatch(ctx context.Context, guildId uint64, ticketId int, event Event, actorId uint64, data any) error {
+	webhooks, err := dbclient.Local.OutboundWebhooks.GetByGuild(ctx, guildId)
+	if err != nil {
+		return err
+	}
+
+	var subscribed []dbclient.OutboundWebhook
+	for _, webhook := range webhooks {
+		if len(webhook.Events) == 0 || utils.Contains(webhook.Events, string(event)) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	eventId, err := generateId()
+	if err != nil {
+		return err
+	}
+
+	body := payload{
+		Id:        eventId,
+		Event:     event,
+		GuildId:   guildId,
+		TicketId:  ticketId,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+
+	if actorId != 0 {
+		body.ActorId = &actorId
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range subscribed {
+		deliveryId, err := generateId()
+		if err != nil {
+			return err
+		}
+
+		if err := queue(ctx, delivery{
+			Id:        deliveryId,
+			WebhookId: webhook.Id,
+			GuildId:   guildId,
+			Event:     event,
+			Payload:   encoded,
+		}, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsEvent returns whether the string is the name of an event that webhooks can subscribe to
+func IsEvent(name string) bool {
+	return utils.Contains(Events, Event(name))
+}
+
+// GenerateSecret generates a secret for signing a guild's webhook payloads
+func GenerateSecret() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
+func queue(ctx context.Context, d delivery, at time.Time) error {
+	encoded, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	return redis.QueueWebhookDelivery(ctx, encoded, at)
+}
+
+func generateId() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
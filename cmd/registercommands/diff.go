@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/manager"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type commandKey struct {
+	name        string
+	commandType uint8
+}
+
+func keyOf(cmd manager.CommandCreateData) commandKey {
+	return commandKey{cmd.Name, uint8(cmd.Type)}
+}
+
+// mergeCommands adds the existing commands that are not part of our payload, but that the operator has asked to keep,
+// such as tag aliases, so that the bulk overwrite leaves them untouched. If keepAll is set, every such command is kept.
+// Any other existing command is removed by the overwrite.
+func mergeCommands(data, existing []manager.CommandCreateData, keepAll bool, keep map[string]bool) (merged []manager.CommandCreateData, preserved []string) {
+	owned := make(map[commandKey]bool)
+	for _, cmd := range data {
+		owned[keyOf(cmd)] = true
+	}
+
+	merged = data
+	for _, cmd := range existing {
+		if !owned[keyOf(cmd)] && (keepAll || keep[cmd.Name]) {
+			merged = append(merged, cmd)
+			preserved = append(preserved, cmd.Name)
+		}
+	}
+
+	return
+}
+
+type commandDiff struct {
+	Added   []string
+	Removed []string
+	Changed map[string][]string
+}
+
+func (d commandDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+func (d commandDiff) String() string {
+	if d.IsEmpty() {
+		return "No changes\n"
+	}
+
+	var sb strings.Builder
+	for _, name := range d.Added {
+		sb.WriteString(fmt.Sprintf("+ %s\n", name))
+	}
+
+	for _, name := range d.Removed {
+		sb.WriteString(fmt.Sprintf("- %s\n", name))
+	}
+
+	names := make([]string, 0, len(d.Changed))
+	for name := range d.Changed {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("~ %s\n", name))
+		for _, change := range d.Changed[name] {
+			sb.WriteString(fmt.Sprintf("    %s\n", change))
+		}
+	}
+
+	return sb.String()
+}
+
+// diffCommands compares the commands currently registered with Discord against the commands we are about to register
+func diffCommands(existing, data []manager.CommandCreateData) commandDiff {
+	diff := commandDiff{
+		Changed: make(map[string][]string),
+	}
+
+	existingByKey := make(map[commandKey]manager.CommandCreateData)
+	for _, cmd := range existing {
+		existingByKey[keyOf(cmd)] = cmd
+	}
+
+	newByKey := make(map[commandKey]manager.CommandCreateData)
+	for _, cmd := range data {
+		newByKey[keyOf(cmd)] = cmd
+
+		old, ok := existingByKey[keyOf(cmd)]
+		if !ok {
+			diff.Added = append(diff.Added, cmd.Name)
+			continue
+		}
+
+		var changes []string
+		changes = append(changes, diffPermissions(old, cmd)...)
+		changes = append(changes, diffFields("", old.Description, cmd.Description, old.DescriptionLocalizations, cmd.DescriptionLocalizations, old.NameLocalizations, cmd.NameLocalizations)...)
+		changes = append(changes, diffOptions("", old.Options, cmd.Options)...)
+
+		if len(changes) > 0 {
+			diff.Changed[cmd.Name] = changes
+		}
+	}
+
+	for _, cmd := range existing {
+		if _, ok := newByKey[keyOf(cmd)]; !ok {
+			diff.Removed = append(diff.Removed, cmd.Name)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+
+	return diff
+}
+
+func diffPermissions(old, cmd manager.CommandCreateData) (changes []string) {
+	if !pointersEqual(old.DefaultMemberPermissions, cmd.DefaultMemberPermissions) {
+		changes = append(changes, fmt.Sprintf("~ default member permissions %s -> %s", formatPointer(old.DefaultMemberPermissions), formatPointer(cmd.DefaultMemberPermissions)))
+	}
+
+	if !pointersEqual(old.DmPermission, cmd.DmPermission) {
+		changes = append(changes, fmt.Sprintf("~ dm permission %s -> %s", formatPointer(old.DmPermission), formatPointer(cmd.DmPermission)))
+	}
+
+	if old.Nsfw != cmd.Nsfw {
+		changes = append(changes, fmt.Sprintf("~ nsfw %t -> %t", old.Nsfw, cmd.Nsfw))
+	}
+
+	return
+}
+
+func diffOptions(path string, existing, data []manager.CommandOption) (changes []string) {
+	existingByName := make(map[string]manager.CommandOption)
+	for _, option := range existing {
+		existingByName[option.Name] = option
+	}
+
+	newByName := make(map[string]manager.CommandOption)
+	for _, option := range data {
+		newByName[option.Name] = option
+		optionPath := path + option.Name
+
+		old, ok := existingByName[option.Name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("+ option %s", optionPath))
+			continue
+		}
+
+		if old.Type != option.Type {
+			changes = append(changes, fmt.Sprintf("~ option %s: type %d -> %d", optionPath, old.Type, option.Type))
+		}
+
+		if old.Required != option.Required {
+			changes = append(changes, fmt.Sprintf("~ option %s: required %t -> %t", optionPath, old.Required, option.Required))
+		}
+
+		if old.Autocomplete != option.Autocomplete {
+			changes = append(changes, fmt.Sprintf("~ option %s: autocomplete %t -> %t", optionPath, old.Autocomplete, option.Autocomplete))
+		}
+
+		if !pointersEqual(old.MinValue, option.MinValue) || !pointersEqual(old.MaxValue, option.MaxValue) ||
+			!pointersEqual(old.MinLength, option.MinLength) || !pointersEqual(old.MaxLength, option.MaxLength) ||
+			!choicesEqual(old.Choices, option.Choices) || !channelTypesEqual(old.ChannelTypes, option.ChannelTypes) {
+			changes = append(changes, fmt.Sprintf("~ option %s: constraints", optionPath))
+		}
+
+		changes = append(changes, diffFields(optionPath, old.Description, option.Description, old.DescriptionLocalizations, option.DescriptionLocalizations, old.NameLocalizations, option.NameLocalizations)...)
+		changes = append(changes, diffOptions(optionPath+" ", old.Options, option.Options)...)
+	}
+
+	for _, option := range existing {
+		if _, ok := newByName[option.Name]; !ok {
+			changes = append(changes, fmt.Sprintf("- option %s%s", path, option.Name))
+		}
+	}
+
+	return
+}
+
+func diffFields(path, oldDescription, newDescription string, oldDescriptions, newDescriptions, oldNames, newNames map[string]string) (changes []string) {
+	prefix := "~ "
+	if path != "" {
+		prefix = fmt.Sprintf("~ option %s: ", path)
+	}
+
+	if oldDescription != newDescription {
+		changes = append(changes, fmt.Sprintf("%sdescription %q -> %q", prefix, oldDescription, newDescription))
+	}
+
+	if !localisationsEqual(oldDescriptions, newDescriptions) {
+		changes = append(changes, fmt.Sprintf("%sdescription localisations (%d -> %d locales)", prefix, len(oldDescriptions), len(newDescriptions)))
+	}
+
+	if !localisationsEqual(oldNames, newNames) {
+		changes = append(changes, fmt.Sprintf("%sname localisations (%d -> %d locales)", prefix, len(oldNames), len(newNames)))
+	}
+
+	return
+}
+
+func localisationsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for locale, value := range a {
+		if other, ok := b[locale]; !ok || other != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// choicesEqual compares choices in order, as Discord shows them in the order given. Values are compared by their
+// string form, as numbers fetched from Discord are decoded as floats, while ours may be integers.
+func choicesEqual(a, b []interaction.ApplicationCommandOptionChoice) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Name != b[i].Name || fmt.Sprint(a[i].Value) != fmt.Sprint(b[i].Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func channelTypesEqual(a, b []channel.ChannelType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func pointersEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func formatPointer[T any](v *T) string {
+	if v == nil {
+		return "unset"
+	}
+
+	return fmt.Sprint(*v)
+}
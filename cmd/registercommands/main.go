@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/jadevelopmentgrp/Tickets-Utilities/observability"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/manager"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
-	"github.com/rxdn/gdl/rest"
 )
 
 var (
@@ -17,12 +21,15 @@ var (
 	GuildId       = flag.Uint64("guild", 0, "Guild to create the commands for")
 
 	AdminCommandGuildId = flag.Uint64("admin-guild", 0, "Guild to create the admin commands in")
-	MergeGuildCommands  = flag.Bool("merge", true, "Don't overwrite existing commands")
+	MergeGuildCommands  = flag.Bool("merge", true, "Keep every existing command that is not part of the payload. Pass -merge=false to remove them")
+	KeepCommands        = flag.String("keep", "", "Comma separated names of existing commands to keep with -merge=false, even though they are not part of the payload")
+	DryRun              = flag.Bool("dry-run", false, "Print the changes that would be made without applying them")
+	Whitelabel          = flag.Bool("whitelabel", false, "Create commands for every whitelabel bot, read from the database")
 )
 
 func main() {
 	flag.Parse()
-	if *Token == "" {
+	if *Token == "" && !*Whitelabel {
 		panic("no token")
 	}
 
@@ -31,23 +38,92 @@ func main() {
 	commandManager := new(manager.CommandManager)
 	commandManager.RegisterCommands()
 
-	data := commandManager.BuildCreatePayload()
+	if !*Whitelabel {
+		if err := deploy(*Token, *ApplicationId, commandManager.BuildCreatePayload(false)); err != nil {
+			panic(err)
+		}
 
+		return
+	}
+
+	config.Parse()
+
+	logger, err := observability.Configure(config.Conf.JsonLogs, config.Conf.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	dbclient.Connect(logger)
+
+	bots := must(dbclient.Local.GetWhitelabelBots(context.Background()))
+	data := commandManager.BuildCreatePayload(true)
+
+	var failed int
+	for _, bot := range bots {
+		fmt.Printf("Whitelabel bot %d\n", bot.BotId)
+
+		// One revoked token shouldn't stop the remaining bots from being updated
+		if err := deploy(bot.Token, bot.BotId, data); err != nil {
+			fmt.Printf("Failed to create commands for bot %d: %v\n", bot.BotId, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("Failed to create commands for %d of %d bots\n", failed, len(bots))
+		os.Exit(1)
+	}
+}
+
+func deploy(token string, applicationId uint64, data []manager.CommandCreateData) error {
+	var existing []manager.CommandCreateData
 	var err error
 	if *GuildId == 0 {
-		must(rest.ModifyGlobalCommands(context.Background(), *Token, nil, *ApplicationId, data))
+		existing, err = manager.GetGlobalCommands(context.Background(), token, nil, applicationId)
 	} else {
-		must(rest.ModifyGuildCommands(context.Background(), *Token, nil, *ApplicationId, *GuildId, data))
+		existing, err = manager.GetGuildCommands(context.Background(), token, nil, applicationId, *GuildId)
 	}
 
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("failed to fetch existing commands: %w", err)
 	}
 
-	cmds := must(rest.GetGlobalCommands(context.Background(), *Token, nil, *ApplicationId))
-	marshalled := must(json.MarshalIndent(cmds, "", "    "))
+	keep := make(map[string]bool)
+	for _, name := range strings.Split(*KeepCommands, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			keep[name] = true
+		}
+	}
+
+	data, preserved := mergeCommands(data, existing, *MergeGuildCommands, keep)
+	for _, name := range preserved {
+		fmt.Printf("Preserving unowned command %s\n", name)
+	}
+
+	fmt.Print(diffCommands(existing, data))
+
+	if *DryRun {
+		return nil
+	}
+
+	var cmds []manager.CommandCreateData
+	if *GuildId == 0 {
+		cmds, err = manager.ModifyGlobalCommands(context.Background(), token, nil, applicationId, data)
+	} else {
+		cmds, err = manager.ModifyGuildCommands(context.Background(), token, nil, applicationId, *GuildId, data)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to modify commands: %w", err)
+	}
+
+	marshalled, err := json.MarshalIndent(cmds, "", "    ")
+	if err != nil {
+		return err
+	}
 
 	fmt.Println(string(marshalled))
+	return nil
 }
 
 func must[T any](t T, err error) T {
@@ -24,6 +24,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/rpc/listeners"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/webhooks"
 	"github.com/jadevelopmentgrp/Tickets-Worker/config"
 	"github.com/jadevelopmentgrp/Tickets-Worker/event"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
@@ -118,13 +119,17 @@ func main() {
 	request.RegisterPostRequestHook(prometheus.PostRequestHook)
 
 	logger.Info("Initialising integrations")
-	integrations.InitIntegrations()
+	if err := integrations.InitIntegrations(); err != nil {
+		logger.Fatal("Failed to initialise integrations", zap.Error(err))
+		return
+	}
 
 	go messagequeue.ListenTicketClose()
 	go messagequeue.ListenAutoClose()
 	go messagequeue.ListenCloseRequestTimer()
 
 	go blacklist.StartCacheRefreshLoop(logger.With(zap.String("service", "blacklist_refresh")))
+	go webhooks.StartDeliveryLoop(logger.With(zap.String("service", "webhook_delivery")))
 
 	if config.Conf.WorkerMode == config.WorkerModeInteractions {
 		logger.Info("Starting HTTP server", zap.String("mode", string(config.Conf.WorkerMode)))
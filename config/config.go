@@ -34,6 +34,16 @@ type (
 			Helpers             []uint64 `env:"WORKER_BOT_HELPERS"`
 		}
 
+		// The first key is used to sign component custom IDs, and all keys are accepted when verifying, so that keys can
+		// be rotated without breaking buttons on existing messages. Signing is disabled if no keys are set. Signed IDs
+		// older than MaxAge are rejected (0 disables expiry), and until AcceptUnsignedUntil (RFC 3339), components sent
+		// before signing was enabled are still accepted without a signature.
+		ComponentSigning struct {
+			Keys                []string      `env:"KEYS"`
+			MaxAge              time.Duration `env:"MAX_AGE" envDefault:"720h"`
+			AcceptUnsignedUntil time.Time     `env:"ACCEPT_UNSIGNED_UNTIL"`
+		} `envPrefix:"WORKER_COMPONENT_SIGNING_"`
+
 		Archiver struct {
 			Url    string `env:"URL"`
 			AesKey string `env:"AES_KEY"`
@@ -48,6 +58,10 @@ type (
 		Integrations struct {
 			BloxlinkApiKey string `env:"BLOXLINK_API_KEY"`
 			SecureProxyUrl string `env:"SECURE_PROXY_URL"`
+			// The providers used for linked account placeholders. bloxlink is built in, and any other provider must be
+			// defined in LinkedAccountHttpProviders, a JSON array of linkedaccounts.HttpProviderConfig.
+			LinkedAccountProviders     []string `env:"LINKED_ACCOUNT_PROVIDERS" envDefault:"bloxlink"`
+			LinkedAccountHttpProviders string   `env:"LINKED_ACCOUNT_HTTP_PROVIDERS"`
 		}
 
 		Database struct {
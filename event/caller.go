@@ -14,6 +14,8 @@ import (
     "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/tags"
     "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/settings/setup"
     "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+    "github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+    "github.com/jadevelopmentgrp/Tickets-Worker/i18n"
     "github.com/pkg/errors"
     "github.com/rxdn/gdl/objects/interaction"
     "strconv"
@@ -31,8 +33,23 @@ func callCommand(
 
         v.Execute(ctx)
     case general.HelpCommand:
+        var arg0 *string
 
-        v.Execute(ctx)
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = &argValue
+        }
+
+        v.Execute(ctx, arg0)
     case general.InviteCommand:
 
         v.Execute(ctx)
@@ -49,6 +66,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -69,6 +89,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -98,6 +121,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -114,9 +140,164 @@ func callCommand(
     case settings.LanguageCommand:
 
         v.Execute(ctx)
+    case settings.ManageCommandsCommand:
+
+        v.Execute(ctx)
+    case settings.ManageCommandsDisableCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case settings.ManageCommandsEnableCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case settings.ManageCommandsListCommand:
+
+        v.Execute(ctx)
+    case settings.ManageCommandsRestrictCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            }
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+            arg1 = argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case settings.ManageCommandsUnrestrictCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            }
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+            arg1 = argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
     case settings.PanelCommand:
 
         v.Execute(ctx)
+    case settings.PlaceholdersCommand:
+
+        v.Execute(ctx)
+    case settings.PlaceholdersPreviewCommand:
+        var arg0 *string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = &argValue
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+
+        v.Execute(ctx, arg0, arg1)
     case settings.RemoveAdminCommand:
         var arg0 uint64
 
@@ -124,6 +305,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -144,6 +328,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -153,41 +340,460 @@ func callCommand(
             if err != nil {
                 return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
             }
-            arg0 = argValue
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case settings.ViewStaffCommand:
+
+        v.Execute(ctx)
+    case setup.AccessCheckSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+        var arg2 *bool
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[2], opt2) {
+                return nil
+            } 
+            argValue, ok := opt2.Value.(bool)
+            if !ok {
+                return fmt.Errorf("option %s was not a bool", opt2.Name)
+            }
+            arg2 = &argValue
+
+            
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2)
+    case setup.AppealsSetupCommand:
+        var arg0 *int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            tmp := int(argValue)
+            arg0 = &tmp
+        }
+
+        v.Execute(ctx, arg0)
+    case setup.AutoSetupCommand:
+
+        v.Execute(ctx)
+    case setup.FeedbackSetupCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case setup.FormConditionSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+        var arg2 *string
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[2], opt2) {
+                return nil
+            } 
+            argValue, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+        var arg3 *string
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[3], opt3) {
+                return nil
+            } 
+            argValue, ok := opt3.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt3.Name)
+            }
+            arg3 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3)
+    case setup.FormValidationSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = argValue
+        }
+        var arg2 *string
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[2], opt2) {
+                return nil
+            } 
+            argValue, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+        var arg3 *int
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[3], opt3) {
+                return nil
+            } 
+            argValue, ok := opt3.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt3.Name)
+            }
+            tmp := int(argValue)
+            arg3 = &tmp
+        }
+        var arg4 *int
+
+        opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+        if !ok4 {
+            arg4 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[4], opt4) {
+                return nil
+            } 
+            argValue, ok := opt4.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt4.Name)
+            }
+            tmp := int(argValue)
+            arg4 = &tmp
+        }
+        var arg5 *float64
+
+        opt5, ok5 := findOption(cmd.Properties().Arguments[5], options)
+        if !ok5 {
+            arg5 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[5], opt5) {
+                return nil
+            } 
+            argValue, ok := opt5.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt5.Name)
+            }
+            arg5 = &argValue
+        }
+        var arg6 *float64
+
+        opt6, ok6 := findOption(cmd.Properties().Arguments[6], options)
+        if !ok6 {
+            arg6 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[6], opt6) {
+                return nil
+            } 
+            argValue, ok := opt6.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt6.Name)
+            }
+            arg6 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+    case setup.IntegrationSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+        var arg2 *int
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[2], opt2) {
+                return nil
+            } 
+            argValue, ok := opt2.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt2.Name)
+            }
+            tmp := int(argValue)
+            arg2 = &tmp
+        }
+        var arg3 *int
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[3], opt3) {
+                return nil
+            } 
+            argValue, ok := opt3.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt3.Name)
+            }
+            tmp := int(argValue)
+            arg3 = &tmp
+        }
+        var arg4 *int
+
+        opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+        if !ok4 {
+            arg4 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[4], opt4) {
+                return nil
+            } 
+            argValue, ok := opt4.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt4.Name)
+            }
+            tmp := int(argValue)
+            arg4 = &tmp
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3, arg4)
+    case setup.LimitSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+
+        v.Execute(ctx, arg0)
+    case setup.SetupCommand:
+
+        v.Execute(ctx)
+    case setup.SurveySelectSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
         }
 
-        v.Execute(ctx, arg0)
-    case settings.ViewStaffCommand:
-
-        v.Execute(ctx)
-    case setup.AutoSetupCommand:
-
-        v.Execute(ctx)
-    case setup.LimitSetupCommand:
-        var arg0 int
+        v.Execute(ctx, arg0, arg1)
+    case setup.TemplatesSetupCommand:
+        var arg0 bool
 
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
-            argValue, ok := opt0.Value.(float64)
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(bool)
             if !ok {
-                return fmt.Errorf("option %s was not a float64", opt0.Name)
+                return fmt.Errorf("option %s was not a bool", opt0.Name)
             }
-            arg0 = int(argValue)
+            arg0 = argValue
+
+            
         }
 
         v.Execute(ctx, arg0)
-    case setup.SetupCommand:
-
-        v.Execute(ctx)
     case setup.ThreadsSetupCommand:
         var arg0 bool
 
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(bool)
             if !ok {
                 return fmt.Errorf("option %s was not a bool", opt0.Name)
@@ -202,6 +808,9 @@ func callCommand(
         if !ok1 {
             arg1 = nil
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            }
             raw, ok := opt1.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt1.Name)
@@ -215,6 +824,24 @@ func callCommand(
         }
 
         v.Execute(ctx, arg0, arg1)
+    case setup.TimezoneSetupCommand:
+        var arg0 *string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = &argValue
+        }
+
+        v.Execute(ctx, arg0)
     case setup.TranscriptsSetupCommand:
         var arg0 uint64
 
@@ -222,6 +849,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -234,6 +864,95 @@ func callCommand(
             arg0 = argValue
         }
 
+        v.Execute(ctx, arg0)
+    case setup.WebhookSetupCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+        var arg2 *bool
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[2], opt2) {
+                return nil
+            } 
+            argValue, ok := opt2.Value.(bool)
+            if !ok {
+                return fmt.Errorf("option %s was not a bool", opt2.Name)
+            }
+            arg2 = &argValue
+
+            
+        }
+        var arg3 *bool
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[3], opt3) {
+                return nil
+            } 
+            argValue, ok := opt3.Value.(bool)
+            if !ok {
+                return fmt.Errorf("option %s was not a bool", opt3.Name)
+            }
+            arg3 = &argValue
+
+            
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3)
+    case setup.WizardSetupCommand:
+
+        v.Execute(ctx)
+    case statistics.FeedbackCommand:
+        var arg0 *int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            tmp := int(argValue)
+            arg0 = &tmp
+        }
+
         v.Execute(ctx, arg0)
     case statistics.StatsCommand:
 
@@ -248,6 +967,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -267,7 +989,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt0.Name)
@@ -279,7 +1004,10 @@ func callCommand(
         opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
         if !ok1 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
             argValue, ok := opt1.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt1.Name)
@@ -297,7 +1025,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt0.Name)
@@ -315,7 +1046,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt0.Name)
@@ -331,6 +1065,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -353,7 +1090,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             arg0 = nil
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt0.Name)
@@ -368,7 +1108,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             arg0 = nil
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(float64)
             if !ok {
                 return fmt.Errorf("option %s was not a float64", opt0.Name)
@@ -381,7 +1124,10 @@ func callCommand(
         opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
         if !ok1 {
             arg1 = nil
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
             argValue, ok := opt1.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt1.Name)
@@ -389,6 +1135,45 @@ func callCommand(
             arg1 = &argValue
         }
 
+        v.Execute(ctx, arg0, arg1)
+    case tickets.HistoryCommand:
+        var arg0 uint64
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
+            raw, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+
         v.Execute(ctx, arg0, arg1)
     case tickets.NotesCommand:
 
@@ -402,7 +1187,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             arg0 = nil
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt0.Name)
@@ -418,6 +1206,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -437,7 +1228,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt0.Name)
@@ -452,7 +1246,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(float64)
             if !ok {
                 return fmt.Errorf("option %s was not a float64", opt0.Name)
@@ -470,7 +1267,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
             argValue, ok := opt0.Value.(float64)
             if !ok {
                 return fmt.Errorf("option %s was not a float64", opt0.Name)
@@ -479,6 +1279,182 @@ func callCommand(
         }
 
         v.Execute(ctx, arg0)
+    case tickets.TicketsCommand:
+
+        v.Execute(ctx)
+    case tickets.TicketsListCommand:
+        var arg0 *string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = &argValue
+        }
+        var arg1 *uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            }
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+        var arg2 *int
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[2], opt2) {
+                return nil
+            } 
+            argValue, ok := opt2.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt2.Name)
+            }
+            tmp := int(argValue)
+            arg2 = &tmp
+        }
+        var arg3 *string
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[3], opt3) {
+                return nil
+            } 
+            argValue, ok := opt3.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt3.Name)
+            }
+            arg3 = &argValue
+        }
+        var arg4 *int
+
+        opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+        if !ok4 {
+            arg4 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[4], opt4) {
+                return nil
+            } 
+            argValue, ok := opt4.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt4.Name)
+            }
+            tmp := int(argValue)
+            arg4 = &tmp
+        }
+        var arg5 *uint64
+
+        opt5, ok5 := findOption(cmd.Properties().Arguments[5], options)
+        if !ok5 {
+            arg5 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[5], opt5) {
+                return nil
+            }
+            raw, ok := opt5.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt5.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt5.Name)
+            }
+            arg5 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3, arg4, arg5)
+    case tickets.TicketsSearchCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[1], opt1) {
+                return nil
+            } 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+        var arg2 *string
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[2], opt2) {
+                return nil
+            } 
+            argValue, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+        var arg3 *uint64
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[3], opt3) {
+                return nil
+            }
+            raw, ok := opt3.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt3.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt3.Name)
+            }
+            arg3 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3)
     case tickets.TransferCommand:
         var arg0 uint64
 
@@ -486,6 +1462,9 @@ func callCommand(
         if !ok0 {
             return ErrArgumentNotFound
         } else {
+            if !validateOption(ctx, cmd.Properties().Arguments[0], opt0) {
+                return nil
+            }
             raw, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a snowflake", opt0.Name)
@@ -502,6 +1481,9 @@ func callCommand(
     case tickets.UnclaimCommand:
 
         v.Execute(ctx)
+    case tickets.ViewTicketHistoryCommand:
+
+        v.Execute(ctx)
 
     
     case tags.TagAliasCommand:
@@ -526,3 +1508,17 @@ func findOption(
 
     return interaction.ApplicationCommandInteractionDataOption{}, false
 }
+
+// validateOption enforces the argument's constraints, replying to the user if they are violated
+func validateOption(
+    ctx *cmdcontext.SlashCommandContext,
+    arg command.Argument,
+    option interaction.ApplicationCommandInteractionDataOption,
+) bool {
+    ok, message, format := arg.Validate(option.Value)
+    if !ok {
+        ctx.Reply(customisation.Red, i18n.Error, message, format...)
+    }
+
+    return ok
+}
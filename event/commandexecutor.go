@@ -16,6 +16,7 @@ import (
 	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/statsd"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
@@ -58,6 +59,8 @@ func executeCommand(
 		ok = true
 	}
 
+	commandPath := []string{cmd.Properties().Name}
+
 	options := data.Data.Options
 	for len(options) > 0 && options[0].Value == nil { // Value and Options are mutually exclusive, value is never present on subcommands
 		subCommand := options[0]
@@ -75,6 +78,7 @@ func executeCommand(
 			return false, fmt.Errorf("subcommand %s does not exist for command %s", subCommand.Name, cmd.Properties().Name)
 		}
 
+		commandPath = append(commandPath, subCommand.Name)
 		options = subCommand.Options
 	}
 
@@ -106,6 +110,13 @@ func executeCommand(
 			return nil
 		})
 
+		// Get guild's restrictions on this command
+		var restrictions []dbclient.CommandRestriction
+		group.Go(func() (err error) {
+			restrictions, err = dbclient.Local.CommandRestrictions.GetByCommands(lookupCtx, data.GuildId.Value, logic.CommandRestrictionKeys(commandPath))
+			return
+		})
+
 		if err := group.Wait(); err != nil {
 			fmt.Print(err)
 			responseCh <- interaction.ApplicationCommandCallbackData{
@@ -140,6 +151,11 @@ func executeCommand(
 			return
 		}
 
+		if permitted, message := logic.CheckCommandRestrictions(restrictions, data.Member.Roles, permLevel); !permitted {
+			interactionContext.Reply(customisation.Red, i18n.Error, message)
+			return
+		}
+
 		// Check for user blacklist - cannot parallelise as relies on permission level
 		// If data.Member is nil, it does not matter, as it is not checked if the command is not executed in a guild
 		blacklisted, err := interactionContext.IsBlacklisted(lookupCtx)
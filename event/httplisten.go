@@ -103,7 +103,7 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 	commandManager.RegisterCommands()
 	commandManager.RunSetupFuncs()
 
-	buttonManager := btn_manager.NewButtonManager()
+	buttonManager := btn_manager.NewButtonManager(commandManager.GetCommands())
 	buttonManager.RegisterCommands()
 
 	return func(ctx *gin.Context) {
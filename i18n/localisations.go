@@ -0,0 +1,43 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CommandNameId returns the ID of the message holding the localised name of the command or subcommand at the given
+// path, e.g. CommandNameId("setup", "limit") for /setup limit.
+func CommandNameId(path ...string) MessageId {
+	return MessageId(fmt.Sprintf("command_names.%s.name", strings.Join(path, ".")))
+}
+
+// ArgumentNameId returns the ID of the message holding the localised name of an argument of the command at the given
+// path, e.g. ArgumentNameId([]string{"setup", "limit"}, "limit") for the limit argument of /setup limit.
+func ArgumentNameId(commandPath []string, argument string) MessageId {
+	return MessageId(fmt.Sprintf("command_names.%s.arguments.%s", strings.Join(commandPath, "."), argument))
+}
+
+// GetLocalisations returns the translation of a message in each language that Discord supports, keyed by Discord
+// locale, for use in command registration. English is the default and so is omitted, as are languages that do not have
+// their own translation of the message.
+func GetLocalisations(id MessageId, format ...interface{}) map[string]string {
+	localisations := make(map[string]string)
+	for _, locale := range Locales {
+		if locale == LocaleEnglish || locale.DiscordLocale == nil {
+			continue
+		}
+
+		value, ok := locale.Messages[id]
+		if !ok || value == "" {
+			continue
+		}
+
+		localisations[*locale.DiscordLocale] = fmt.Sprintf(strings.Replace(value, "\\n", "\n", -1), format...)
+	}
+
+	if len(localisations) == 0 {
+		return nil
+	}
+
+	return localisations
+}
@@ -49,6 +49,13 @@ var (
 	TitlePanelSwitched     MessageId = "generic.title.panel_switched"
 	TitleJumpToTop         MessageId = "generic.title.jump_to_top"
 	TitleReopened          MessageId = "generic.title.reopened"
+	TitleUnblacklist       MessageId = "generic.title.unblacklist"
+	TitleManageCommands    MessageId = "generic.title.manage_commands"
+	TitleTicketList        MessageId = "generic.title.ticket_list"
+	TitleTicketHistory     MessageId = "generic.title.ticket_history"
+	TitleTicketSearch      MessageId = "generic.title.ticket_search"
+	TitleForm              MessageId = "generic.title.form"
+	TitlePlaceholders      MessageId = "generic.title.placeholders"
 
 	MessageAbout MessageId = "commands.about"
 
@@ -60,9 +67,13 @@ var (
 	MessageVoteRedeemSuccessSingular MessageId = "commands.vote.redeem.success.singular"
 	MessageVoteRedeemSuccessPlural   MessageId = "commands.vote.redeem.success.plural"
 	MessageInvalidArgument           MessageId = "generic.invalid_argument"
+	MessageArgumentTooSmall          MessageId = "generic.argument_constraint.too_small"
+	MessageArgumentTooLarge          MessageId = "generic.argument_constraint.too_large"
+	MessageArgumentTooShort          MessageId = "generic.argument_constraint.too_short"
+	MessageArgumentTooLong           MessageId = "generic.argument_constraint.too_long"
+	MessageArgumentInvalidChoice     MessageId = "generic.argument_constraint.invalid_choice"
 	MessageJoinSupportServer         MessageId = "generic.join_support_server"
 	MessageCloseNoPermission         MessageId = "close.no_permission"
-	MessageCloseReasonTooLong        MessageId = "close.reason_too_long"
 	MessageCloseReasonPlaceholder    MessageId = "close.reason.placeholder"
 	MessageCloseConfirmation         MessageId = "close.confirmation"
 	MessageCloseSuccess              MessageId = "close.success"
@@ -71,7 +82,6 @@ var (
 
 	MessageTag                       MessageId = "commands.tag.generic"
 	MessageTagCreateInvalidArguments MessageId = "commands.tags.create.invalid_arguments"
-	MessageTagCreateTooLong          MessageId = "commands.tags.create.too_long"
 	MessageTagCreateAlreadyExists    MessageId = "commands.tags.create.already_exists"
 	MessageTagCreateLimit            MessageId = "commands.tags.create.limit"
 	MessageTagCreateSuccess          MessageId = "commands.tags.create.success"
@@ -95,6 +105,8 @@ var (
 	MessageOpenAclNotAllowListedSingle   MessageId = "open.acl.not_allow_listed.single"
 	MessageOpenAclNotAllowListedMultiple MessageId = "open.acl.not_allow_listed.multiple"
 	MessageOpenAclDenyListed             MessageId = "open.acl.deny_listed"
+	MessageOpenAccessCheckDenied         MessageId = "open.access_check.denied"
+	MessageOpenAccessCheckFailed         MessageId = "open.access_check.failed"
 
 	MessageAddAdminNoMembers   MessageId = "commands.addadmin.no_members"
 	MessageAddAdminConfirm     MessageId = "commands.addadmin.confirm"
@@ -119,6 +131,37 @@ var (
 	MessageBlacklistRemove     MessageId = "commands.blacklist.remove.success"
 	MessageBlacklistRemoveRole MessageId = "commands.blacklist.remove_role.success"
 
+	MessageBlacklistAppealAlreadyOpen    MessageId = "blacklist.appeal.already_open"
+	MessageBlacklistAppealNotAppeal      MessageId = "blacklist.appeal.not_appeal"
+	MessageBlacklistAppealNotBlacklisted MessageId = "blacklist.appeal.not_blacklisted"
+	MessageBlacklistAppealRoleBlacklist  MessageId = "blacklist.appeal.role_blacklisted"
+	MessageBlacklistAppealSuccess        MessageId = "blacklist.appeal.success"
+
+	MessageCommandDisabled              MessageId = "commands.managecommands.disabled"
+	MessageCommandRoleRestricted        MessageId = "commands.managecommands.role_restricted"
+	MessageManageCommandsInvalidCommand MessageId = "commands.managecommands.invalid_command"
+	MessageManageCommandsUnrestrictable MessageId = "commands.managecommands.unrestrictable"
+	MessageManageCommandsDisabled       MessageId = "commands.managecommands.disable_success"
+	MessageManageCommandsEnabled        MessageId = "commands.managecommands.enable_success"
+	MessageManageCommandsRoleAdded      MessageId = "commands.managecommands.restrict_success"
+	MessageManageCommandsRoleRemoved    MessageId = "commands.managecommands.unrestrict_success"
+	MessageManageCommandsList           MessageId = "commands.managecommands.list"
+	MessageManageCommandsListEmpty      MessageId = "commands.managecommands.list_empty"
+	MessageManageCommandsListDisabled   MessageId = "commands.managecommands.list_disabled"
+
+	MessagePlaceholdersTagAndPanel       MessageId = "commands.placeholders.preview.tag_and_panel"
+	MessagePlaceholdersInvalidPanel      MessageId = "commands.placeholders.preview.invalid_panel"
+	MessagePlaceholdersSampleTicket      MessageId = "commands.placeholders.preview.sample_ticket"
+	MessagePlaceholdersNoProblems        MessageId = "commands.placeholders.preview.no_problems"
+	MessagePlaceholdersUnknown           MessageId = "commands.placeholders.preview.unknown"
+	MessagePlaceholdersFailing           MessageId = "commands.placeholders.preview.failing"
+	MessagePlaceholdersTemplateError     MessageId = "commands.placeholders.preview.template_error"
+	MessagePlaceholdersTemplatesDisabled MessageId = "commands.placeholders.preview.templates_disabled"
+	MessagePlaceholdersIntegrationFailed MessageId = "commands.placeholders.preview.integration_failed"
+	MessagePlaceholdersIntegrationNoBody MessageId = "commands.placeholders.preview.integration_no_body"
+	MessagePlaceholdersAvailable         MessageId = "commands.placeholders.preview.available"
+	MessagePlaceholdersLiveIntegrations  MessageId = "commands.placeholders.preview.live_integrations"
+
 	MessageClaimed           MessageId = "commands.claim.success"
 	MessageClaimNoPermission MessageId = "commands.claim.no_permission"
 	MessageClaimThread       MessageId = "commands.claim.thread"
@@ -140,7 +183,6 @@ var (
 
 	MessageRenamed           MessageId = "commands.rename.success"
 	MessageRenameMissingName MessageId = "commands.rename.missing_name"
-	MessageRenameTooLong     MessageId = "commands.rename.too_long"
 	MessageRenameRatelimited MessageId = "commands.rename.ratelimited"
 
 	MessageNotClaimed            MessageId = "commands.unclaim.not_claimed"
@@ -155,11 +197,27 @@ var (
 	MessageGuildChannelLimitReached MessageId = "commands.open.guild_channel_limit"
 	MessageTicketStartedFrom        MessageId = "commands.open.from"
 	MessageMovedToTicket            MessageId = "commands.open.from.moved"
-	MessageFormMissingInput         MessageId = "commands.open.missing_form_answer"
 	MessageOpenCommandDisabled      MessageId = "commands.open.disabled"
 	MessageOpenCantSeeParentChannel MessageId = "commands.open.threads.cant_see_parent_channel"
 	MessageOpenCantMessageInThreads MessageId = "commands.open.threads.cant_message_in_threads"
 
+	MessageFormContinue              MessageId = "commands.open.form.continue"
+	MessageFormContinueButton        MessageId = "commands.open.form.continue_button"
+	MessageFormValidationFailed      MessageId = "commands.open.form_validation.failed"
+	MessageFormValidationEdit        MessageId = "commands.open.form_validation.edit"
+	MessageFormValidationFormChanged MessageId = "commands.open.form_validation.form_changed"
+	MessageFormValidationRequired    MessageId = "commands.open.form_validation.required"
+	MessageFormValidationTooShort    MessageId = "commands.open.form_validation.too_short"
+	MessageFormValidationTooLong     MessageId = "commands.open.form_validation.too_long"
+	MessageFormValidationPattern     MessageId = "commands.open.form_validation.pattern"
+	MessageFormValidationInteger     MessageId = "commands.open.form_validation.integer"
+	MessageFormValidationDecimal     MessageId = "commands.open.form_validation.decimal"
+	MessageFormValidationEmail       MessageId = "commands.open.form_validation.email"
+	MessageFormValidationUrl         MessageId = "commands.open.form_validation.url"
+	MessageFormValidationSnowflake   MessageId = "commands.open.form_validation.snowflake"
+	MessageFormValidationTooSmall    MessageId = "commands.open.form_validation.too_small"
+	MessageFormValidationTooLarge    MessageId = "commands.open.form_validation.too_large"
+
 	MessageCloseRequestNoReason     MessageId = "commands.close_request.no_reason"
 	MessageCloseRequestWithReason   MessageId = "commands.close_request.with_reason"
 	MessageCloseRequestNoPermission MessageId = "commands.close_request.no_permission"
@@ -185,6 +243,34 @@ var (
 	MessageOnCallSuccess       MessageId = "commands.on_call.success"
 	MessageOnCallRemoveSuccess MessageId = "commands.on_call.remove_success"
 
+	MessageTicketListEntry          MessageId = "commands.tickets.list.entry"
+	MessageTicketListEmpty          MessageId = "commands.tickets.list.empty"
+	MessageTicketListPage           MessageId = "commands.tickets.list.page"
+	MessageTicketListUnclaimed      MessageId = "commands.tickets.list.unclaimed"
+	MessageTicketListClaimedBy      MessageId = "commands.tickets.list.claimed_by"
+	MessageTicketListStatusOpen     MessageId = "commands.tickets.list.status.open"
+	MessageTicketListStatusPending  MessageId = "commands.tickets.list.status.pending"
+	MessageTicketListAlreadyClaimed MessageId = "commands.tickets.list.already_claimed"
+	MessageTicketListNotOwner       MessageId = "commands.tickets.list.not_owner"
+	MessageTicketListCancel         MessageId = "commands.tickets.list.cancel"
+
+	MessageTicketHistoryDescription MessageId = "commands.history.description"
+	MessageTicketHistoryPage        MessageId = "commands.history.page"
+	MessageTicketHistoryOpened      MessageId = "commands.history.opened"
+	MessageTicketHistoryStillOpen   MessageId = "commands.history.still_open"
+	MessageTicketHistoryClosed      MessageId = "commands.history.closed"
+	MessageTicketHistoryReason      MessageId = "commands.history.reason"
+	MessageTicketHistoryClaimedBy   MessageId = "commands.history.claimed_by"
+	MessageTicketHistoryRating      MessageId = "commands.history.rating"
+	MessageTicketHistoryTranscript  MessageId = "commands.history.transcript"
+	MessageTicketHistoryAllPanels   MessageId = "commands.history.all_panels"
+	MessageTicketHistorySelectPanel MessageId = "commands.history.select_panel"
+
+	MessageTicketSearchDescription MessageId = "commands.tickets.search.description"
+	MessageTicketSearchPage        MessageId = "commands.tickets.search.page"
+	MessageTicketSearchOpenedBy    MessageId = "commands.tickets.search.opened_by"
+	MessageTicketSearchInvalidDate MessageId = "commands.tickets.search.invalid_date"
+
 	MessageReopenTicketNotFound MessageId = "commands.reopen.not_found"
 	MessageReopenNoPermission   MessageId = "commands.reopen.no_permission"
 	MessageReopenAlreadyOpen    MessageId = "commands.reopen.already_open"
@@ -226,52 +312,259 @@ var (
 	SetupThreadsSuccess                 MessageId = "setup.threads.success"
 	SetupThreadsDisabled                MessageId = "setup.threads.disabled"
 
+	SetupTemplatesEnabled  MessageId = "setup.templates.enabled"
+	SetupTemplatesDisabled MessageId = "setup.templates.disabled"
+
+	SetupTimezoneSet     MessageId = "setup.timezone.set"
+	SetupTimezoneReset   MessageId = "setup.timezone.reset"
+	SetupTimezoneInvalid MessageId = "setup.timezone.invalid"
+
+	SetupFeedbackSuccess           MessageId = "setup.feedback.success"
+	SetupFeedbackSuccessWithReason MessageId = "setup.feedback.success_with_reason"
+	SetupFeedbackInvalidThreshold  MessageId = "setup.feedback.invalid_threshold"
+
+	SetupSurveySelectSuccess          MessageId = "setup.survey_select.success"
+	SetupSurveySelectRemoved          MessageId = "setup.survey_select.removed"
+	SetupSurveySelectInvalidOptions   MessageId = "setup.survey_select.invalid_options"
+	SetupSurveySelectTooManyQuestions MessageId = "setup.survey_select.too_many_questions"
+
+	SetupAppealsInvalidPanel MessageId = "setup.appeals.invalid_panel"
+	SetupAppealsSuccess      MessageId = "setup.appeals.success"
+	SetupAppealsDisabled     MessageId = "setup.appeals.disabled"
+
+	SetupWizardStep        MessageId = "setup.wizard.step"
+	SetupWizardSelect      MessageId = "setup.wizard.select"
+	SetupWizardSelectPage  MessageId = "setup.wizard.select_page"
+	SetupWizardCreateNew   MessageId = "setup.wizard.create_new"
+	SetupWizardNone        MessageId = "setup.wizard.none"
+	SetupWizardKeepCurrent MessageId = "setup.wizard.keep_current"
+	SetupWizardBack        MessageId = "setup.wizard.back"
+	SetupWizardNext        MessageId = "setup.wizard.next"
+	SetupWizardCancel      MessageId = "setup.wizard.cancel"
+	SetupWizardConfirm     MessageId = "setup.wizard.confirm"
+	SetupWizardCancelled   MessageId = "setup.wizard.cancelled"
+	SetupWizardCompleted   MessageId = "setup.wizard.completed"
+
+	SetupWizardMode                           MessageId = "setup.wizard.mode.title"
+	SetupWizardModeDescription                MessageId = "setup.wizard.mode.description"
+	SetupWizardModeChannels                   MessageId = "setup.wizard.mode.channels"
+	SetupWizardModeThreads                    MessageId = "setup.wizard.mode.threads"
+	SetupWizardCategory                       MessageId = "setup.wizard.category.title"
+	SetupWizardCategoryDescription            MessageId = "setup.wizard.category.description"
+	SetupWizardNotificationChannel            MessageId = "setup.wizard.notification_channel.title"
+	SetupWizardNotificationChannelDescription MessageId = "setup.wizard.notification_channel.description"
+	SetupWizardTranscripts                    MessageId = "setup.wizard.transcripts.title"
+	SetupWizardTranscriptsDescription         MessageId = "setup.wizard.transcripts.description"
+	SetupWizardSupportRole                    MessageId = "setup.wizard.support_role.title"
+	SetupWizardSupportRoleDescription         MessageId = "setup.wizard.support_role.description"
+	SetupWizardAdminRole                      MessageId = "setup.wizard.admin_role.title"
+	SetupWizardAdminRoleDescription           MessageId = "setup.wizard.admin_role.description"
+	SetupWizardLimit                          MessageId = "setup.wizard.limit.title"
+	SetupWizardLimitDescription               MessageId = "setup.wizard.limit.description"
+	SetupWizardLanguage                       MessageId = "setup.wizard.language.title"
+	SetupWizardLanguageDescription            MessageId = "setup.wizard.language.description"
+	SetupWizardPanel                          MessageId = "setup.wizard.panel.title"
+	SetupWizardPanelDescription               MessageId = "setup.wizard.panel.description"
+	SetupWizardSummary                        MessageId = "setup.wizard.summary.title"
+	SetupWizardSummaryDescription             MessageId = "setup.wizard.summary.description"
+
+	SetupWizardPanelTitle   MessageId = "setup.wizard.panel.default_title"
+	SetupWizardPanelContent MessageId = "setup.wizard.panel.default_content"
+	SetupWizardPanelButton  MessageId = "setup.wizard.panel.default_button"
+
+	SetupFormConditionSuccess           MessageId = "setup.form_condition.success"
+	SetupFormConditionRemoved           MessageId = "setup.form_condition.removed"
+	SetupFormConditionInvalidDependency MessageId = "setup.form_condition.invalid_dependency"
+	SetupFormConditionMissingValue      MessageId = "setup.form_condition.missing_value"
+
+	SetupAccessCheckSuccess            MessageId = "setup.access_check.success"
+	SetupAccessCheckRemoved            MessageId = "setup.access_check.removed"
+	SetupAccessCheckInvalidPanel       MessageId = "setup.access_check.invalid_panel"
+	SetupAccessCheckInvalidIntegration MessageId = "setup.access_check.invalid_integration"
+
+	SetupIntegrationSuccess MessageId = "setup.integration.success"
+
+	SetupWebhookSuccess           MessageId = "setup.webhook.success"
+	SetupWebhookSuccessWithSecret MessageId = "setup.webhook.success_with_secret"
+	SetupWebhookRemoved           MessageId = "setup.webhook.removed"
+	SetupWebhookNotFound          MessageId = "setup.webhook.not_found"
+	SetupWebhookInvalidUrl        MessageId = "setup.webhook.invalid_url"
+	SetupWebhookInvalidEvent      MessageId = "setup.webhook.invalid_event"
+	SetupWebhookLimit             MessageId = "setup.webhook.limit"
+
+	SetupFormValidationSuccess        MessageId = "setup.form_validation.success"
+	SetupFormValidationRemoved        MessageId = "setup.form_validation.removed"
+	SetupFormValidationInvalidInput   MessageId = "setup.form_validation.invalid_input"
+	SetupFormValidationMissingPattern MessageId = "setup.form_validation.missing_pattern"
+	SetupFormValidationInvalidPattern MessageId = "setup.form_validation.invalid_pattern"
+	SetupFormValidationInvalidRange   MessageId = "setup.form_validation.invalid_range"
+	SetupFormValidationNotNumeric     MessageId = "setup.form_validation.not_numeric"
+
+	MessageHelpSelectCategory MessageId = "commands.help.select_category"
+	MessageHelpPage           MessageId = "commands.help.page"
+	MessageHelpSearchResults  MessageId = "commands.help.search_results"
+	MessageHelpNoResults      MessageId = "commands.help.no_results"
+
 	MessageOwnerIsAlreadyAdmin MessageId = "commands.addadmin.owner"
 	MessageHelpInvite          MessageId = "help.invite"
 	MessageInvite              MessageId = "commands.invite"
 
-	MessageFeedbackDisabled MessageId = "feedback.disabled"
-	MessageFeedbackSuccess  MessageId = "feedback.success"
-
-	MessageButtonGuildOnly MessageId = "button.guild_only"
-	MessageButtonDMOnly    MessageId = "button.dms_only"
-
-	HelpAdmin              MessageId = "help.admin"
-	HelpAdminGetOwner      MessageId = "help.admin.get_owner"
-	HelpAbout              MessageId = "help.about"
-	HelpAutoClose          MessageId = "help.autoclose"
-	HelpAutoCloseExclude   MessageId = "help.autoclose.exclude"
-	HelpAutoCloseConfigure MessageId = "help.autoclose.configure"
-	HelpVote               MessageId = "help.vote"
-	HelpAddAdmin           MessageId = "help.addadmin"
-	HelpAddSupport         MessageId = "help.addsupport"
-	HelpBlacklist          MessageId = "help.blacklist"
-	HelpPanel              MessageId = "help.panel"
-	HelpRemoveSupport      MessageId = "help.removesupport"
-	HelpSetup              MessageId = "help.setup"
-	HelpViewStaff          MessageId = "help.viewstaff"
-	HelpStats              MessageId = "help.stats"
-	HelpStatsServer        MessageId = "help.statsserver"
-	HelpManageTags         MessageId = "help.managetags"
-	HelpTagAdd             MessageId = "help.taggadd"
-	HelpTagDelete          MessageId = "help.tagdelete"
-	HelpTagList            MessageId = "help.taglist"
-	HelpTag                MessageId = "help.tag"
-	HelpAdd                MessageId = "help.add"
-	HelpClaim              MessageId = "help.claim"
-	HelpClose              MessageId = "help.close"
-	HelpCloseRequest       MessageId = "help.close_request"
-	HelpNotes              MessageId = "help.notes"
-	HelpOpen               MessageId = "help.open"
-	HelpRemove             MessageId = "help.remove"
-	HelpRename             MessageId = "help.rename"
-	HelpReopen             MessageId = "help.reopen"
-	HelpTransfer           MessageId = "help.transfer"
-	HelpUnclaim            MessageId = "help.unclaim"
-	HelpHelp               MessageId = "help.help"
-	HelpRemoveAdmin        MessageId = "help.removeadmin"
-	HelpLanguage           MessageId = "help.language"
-	HelpSwitchPanel        MessageId = "help.switch_panel"
-	HelpJumpToTop          MessageId = "help.jump_to_top"
-	HelpOnCall             MessageId = "help.on_call"
+	MessageFeedbackDisabled         MessageId = "feedback.disabled"
+	MessageFeedbackSuccess          MessageId = "feedback.success"
+	MessageFeedbackReasonButton     MessageId = "feedback.reason.button"
+	MessageFeedbackReasonPrompt     MessageId = "feedback.reason.prompt"
+	MessageFeedbackReasonSuccess    MessageId = "feedback.reason.success"
+	MessageFeedbackReasonNotRated   MessageId = "feedback.reason.not_rated"
+	MessageFeedbackThumbsDown       MessageId = "feedback.thumbs.down"
+	MessageFeedbackThumbsUp         MessageId = "feedback.thumbs.up"
+	MessageExitSurveySelectPrompt   MessageId = "feedback.survey.select_prompt"
+	MessageExitSurveySelectRequired MessageId = "feedback.survey.select_required"
+
+	MessageFeedbackTicketNotFound MessageId = "commands.feedback.ticket_not_found"
+	MessageFeedbackNoFeedback     MessageId = "commands.feedback.no_feedback"
+
+	MessageButtonGuildOnly        MessageId = "button.guild_only"
+	MessageButtonDMOnly           MessageId = "button.dms_only"
+	MessageButtonInvalidSignature MessageId = "button.invalid_signature"
+	MessageButtonSignatureExpired MessageId = "button.signature_expired"
+	MessageButtonStateExpired     MessageId = "button.state_expired"
+	MessageButtonStateNotOwner    MessageId = "button.state_not_owner"
+
+	HelpAdmin               MessageId = "help.admin"
+	HelpAdminGetOwner       MessageId = "help.admin.get_owner"
+	HelpAbout               MessageId = "help.about"
+	HelpAutoClose           MessageId = "help.autoclose"
+	HelpAutoCloseExclude    MessageId = "help.autoclose.exclude"
+	HelpAutoCloseConfigure  MessageId = "help.autoclose.configure"
+	HelpVote                MessageId = "help.vote"
+	HelpAddAdmin            MessageId = "help.addadmin"
+	HelpAddSupport          MessageId = "help.addsupport"
+	HelpBlacklist           MessageId = "help.blacklist"
+	HelpPanel               MessageId = "help.panel"
+	HelpRemoveSupport       MessageId = "help.removesupport"
+	HelpSetup               MessageId = "help.setup"
+	HelpSetupAccessCheck    MessageId = "help.setup.access_check"
+	HelpSetupAppeals        MessageId = "help.setup.appeals"
+	HelpSetupFeedback       MessageId = "help.setup.feedback"
+	HelpSetupFormCondition  MessageId = "help.setup.form_condition"
+	HelpSetupFormValidation MessageId = "help.setup.form_validation"
+	HelpSetupIntegration    MessageId = "help.setup.integration"
+	HelpSetupSurveySelect   MessageId = "help.setup.survey_select"
+	HelpSetupTemplates      MessageId = "help.setup.templates"
+	HelpSetupTimezone       MessageId = "help.setup.timezone"
+	HelpSetupWebhook        MessageId = "help.setup.webhook"
+	HelpSetupWizard         MessageId = "help.setup.wizard"
+	HelpViewStaff           MessageId = "help.viewstaff"
+	HelpStats               MessageId = "help.stats"
+	HelpFeedback            MessageId = "help.feedback"
+	HelpStatsServer         MessageId = "help.statsserver"
+	HelpManageTags          MessageId = "help.managetags"
+	HelpTagAdd              MessageId = "help.taggadd"
+	HelpTagDelete           MessageId = "help.tagdelete"
+	HelpTagList             MessageId = "help.taglist"
+	HelpTag                 MessageId = "help.tag"
+	HelpAdd                 MessageId = "help.add"
+	HelpClaim               MessageId = "help.claim"
+	HelpClose               MessageId = "help.close"
+	HelpCloseRequest        MessageId = "help.close_request"
+	HelpNotes               MessageId = "help.notes"
+	HelpOpen                MessageId = "help.open"
+	HelpRemove              MessageId = "help.remove"
+	HelpRename              MessageId = "help.rename"
+	HelpReopen              MessageId = "help.reopen"
+	HelpTransfer            MessageId = "help.transfer"
+	HelpUnclaim             MessageId = "help.unclaim"
+	HelpHelp                MessageId = "help.help"
+	HelpRemoveAdmin         MessageId = "help.removeadmin"
+	HelpLanguage            MessageId = "help.language"
+	HelpSwitchPanel         MessageId = "help.switch_panel"
+	HelpJumpToTop           MessageId = "help.jump_to_top"
+	HelpOnCall              MessageId = "help.on_call"
+	HelpTickets             MessageId = "help.tickets"
+	HelpTicketsList         MessageId = "help.tickets.list"
+	HelpTicketsSearch       MessageId = "help.tickets.search"
+	HelpHistory             MessageId = "help.history"
+
+	HelpManageCommands           MessageId = "help.managecommands"
+	HelpManageCommandsDisable    MessageId = "help.managecommands.disable"
+	HelpManageCommandsEnable     MessageId = "help.managecommands.enable"
+	HelpManageCommandsRestrict   MessageId = "help.managecommands.restrict"
+	HelpManageCommandsUnrestrict MessageId = "help.managecommands.unrestrict"
+	HelpManageCommandsList       MessageId = "help.managecommands.list"
+
+	HelpPlaceholders        MessageId = "help.placeholders"
+	HelpPlaceholdersPreview MessageId = "help.placeholders.preview"
+
+	ArgumentStatsUser                       MessageId = "arguments.stats.user"
+	ArgumentFeedbackTicket                  MessageId = "arguments.feedback.ticket"
+	ArgumentAddSupportRole                  MessageId = "arguments.addsupport.role"
+	ArgumentBlacklistUserOrRole             MessageId = "arguments.blacklist.user_or_role"
+	ArgumentAddAdminUserOrRole              MessageId = "arguments.addadmin.user_or_role"
+	ArgumentSetupThreadsUseThreads          MessageId = "arguments.setup.threads.use_threads"
+	ArgumentSetupThreadsNotificationChannel MessageId = "arguments.setup.threads.ticket_notification_channel"
+	ArgumentSetupTemplatesEnabled           MessageId = "arguments.setup.templates.enabled"
+	ArgumentSetupTimezoneTimezone           MessageId = "arguments.setup.timezone.timezone"
+	ArgumentSetupFeedbackScale              MessageId = "arguments.setup.feedback.scale"
+	ArgumentSetupFeedbackReasonThreshold    MessageId = "arguments.setup.feedback.reason_threshold"
+	ArgumentSetupSurveySelectInput          MessageId = "arguments.setup.survey_select.input"
+	ArgumentSetupSurveySelectOptions        MessageId = "arguments.setup.survey_select.options"
+	ArgumentSetupLimit                      MessageId = "arguments.setup.limit.limit"
+	ArgumentSetupTranscriptsChannel         MessageId = "arguments.setup.transcripts.channel"
+	ArgumentSetupAppealsPanel               MessageId = "arguments.setup.appeals.panel"
+	ArgumentSetupAccessCheckPanel           MessageId = "arguments.setup.access_check.panel"
+	ArgumentSetupAccessCheckIntegration     MessageId = "arguments.setup.access_check.integration"
+	ArgumentSetupAccessCheckAllowOnError    MessageId = "arguments.setup.access_check.allow_on_error"
+	ArgumentSetupIntegrationIntegration     MessageId = "arguments.setup.integration.integration"
+	ArgumentSetupIntegrationCacheTtl        MessageId = "arguments.setup.integration.cache_ttl"
+	ArgumentSetupIntegrationTimeout         MessageId = "arguments.setup.integration.timeout"
+	ArgumentSetupIntegrationThreshold       MessageId = "arguments.setup.integration.failure_threshold"
+	ArgumentSetupIntegrationOpenDuration    MessageId = "arguments.setup.integration.open_duration"
+	ArgumentSetupWebhookUrl                 MessageId = "arguments.setup.webhook.url"
+	ArgumentSetupWebhookEvents              MessageId = "arguments.setup.webhook.events"
+	ArgumentSetupWebhookRemove              MessageId = "arguments.setup.webhook.remove"
+	ArgumentSetupWebhookRegenerateSecret    MessageId = "arguments.setup.webhook.regenerate_secret"
+	ArgumentSetupFormConditionInput         MessageId = "arguments.setup.form_condition.input"
+	ArgumentSetupFormConditionDependsOn     MessageId = "arguments.setup.form_condition.depends_on"
+	ArgumentSetupFormConditionOperator      MessageId = "arguments.setup.form_condition.operator"
+	ArgumentSetupFormConditionValue         MessageId = "arguments.setup.form_condition.value"
+	ArgumentSetupFormValidationInput        MessageId = "arguments.setup.form_validation.input"
+	ArgumentSetupFormValidationType         MessageId = "arguments.setup.form_validation.type"
+	ArgumentSetupFormValidationPattern      MessageId = "arguments.setup.form_validation.pattern"
+	ArgumentSetupFormValidationMinLength    MessageId = "arguments.setup.form_validation.min_length"
+	ArgumentSetupFormValidationMaxLength    MessageId = "arguments.setup.form_validation.max_length"
+	ArgumentSetupFormValidationMinValue     MessageId = "arguments.setup.form_validation.min_value"
+	ArgumentSetupFormValidationMaxValue     MessageId = "arguments.setup.form_validation.max_value"
+	ArgumentPlaceholdersPreviewTag          MessageId = "arguments.placeholders.preview.tag"
+	ArgumentPlaceholdersPreviewPanel        MessageId = "arguments.placeholders.preview.panel"
+	ArgumentRemoveAdminUserOrRole           MessageId = "arguments.removeadmin.user_or_role"
+	ArgumentRemoveSupportUserOrRole         MessageId = "arguments.removesupport.user_or_role"
+	ArgumentRemoveUser                      MessageId = "arguments.remove.user"
+	ArgumentAddUser                         MessageId = "arguments.add.user"
+	ArgumentOpenSubject                     MessageId = "arguments.open.subject"
+	ArgumentRenameName                      MessageId = "arguments.rename.name"
+	ArgumentReopenTicketId                  MessageId = "arguments.reopen.ticket_id"
+	ArgumentSwitchPanelPanel                MessageId = "arguments.switchpanel.panel"
+	ArgumentCloseRequestCloseDelay          MessageId = "arguments.closerequest.close_delay"
+	ArgumentCloseRequestReason              MessageId = "arguments.closerequest.reason"
+	ArgumentCloseReason                     MessageId = "arguments.close.reason"
+	ArgumentTransferUser                    MessageId = "arguments.transfer.user"
+	ArgumentManageTagsAddId                 MessageId = "arguments.managetags.add.id"
+	ArgumentManageTagsAddContent            MessageId = "arguments.managetags.add.content"
+	ArgumentManageTagsDeleteId              MessageId = "arguments.managetags.delete.id"
+	ArgumentTagId                           MessageId = "arguments.tag.id"
+	ArgumentManageCommandsCommand           MessageId = "arguments.managecommands.command"
+	ArgumentManageCommandsRole              MessageId = "arguments.managecommands.role"
+	ArgumentHelpQuery                       MessageId = "arguments.help.query"
+	ArgumentTicketsListClaimedBy            MessageId = "arguments.tickets.list.claimed_by"
+	ArgumentTicketsListClaimer              MessageId = "arguments.tickets.list.claimer"
+	ArgumentTicketsListPanel                MessageId = "arguments.tickets.list.panel"
+	ArgumentTicketsListStatus               MessageId = "arguments.tickets.list.status"
+	ArgumentTicketsListOlderThan            MessageId = "arguments.tickets.list.older_than"
+	ArgumentTicketsListOpener               MessageId = "arguments.tickets.list.opener"
+	ArgumentTicketsSearchQuery              MessageId = "arguments.tickets.search.query"
+	ArgumentTicketsSearchAfter              MessageId = "arguments.tickets.search.after"
+	ArgumentTicketsSearchBefore             MessageId = "arguments.tickets.search.before"
+	ArgumentTicketsSearchStaff              MessageId = "arguments.tickets.search.staff"
+	ArgumentHistoryUser                     MessageId = "arguments.history.user"
+	ArgumentHistoryPanel                    MessageId = "arguments.history.panel"
 )